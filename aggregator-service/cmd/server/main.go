@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"aggregator-service/internal/aggregator"
+	"aggregator-service/internal/cache"
+	"aggregator-service/internal/config"
+	"aggregator-service/internal/connector"
+	"aggregator-service/internal/connector/drom"
+	"aggregator-service/internal/delivery"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// connectors - конструкторы доступных источников по имени из конфигурации
+var connectors = map[string]func(cfg config.ConnectorConfig) connector.Connector{
+	drom.Name: func(cfg config.ConnectorConfig) connector.Connector {
+		return drom.New(cfg.BaseURL, cfg.MaxPages, &http.Client{})
+	},
+}
+
+func main() {
+	// Создаем логгер
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatalf("Ошибка при создании логгера: %v", err)
+	}
+	defer logger.Sync()
+
+	// Загружаем конфигурацию
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Ошибка загрузки конфигурации", zap.Error(err))
+	}
+
+	// Результаты источников кэшируются, одинаковые одновременные запросы объединяются
+	var searchCache cache.Cache
+	switch cfg.Cache.Backend {
+	case config.CacheRedis:
+		redisCache := cache.NewRedis(redis.NewClient(&redis.Options{
+			Addr:     cfg.Cache.Redis.Addr,
+			Password: cfg.Cache.Redis.Password,
+			DB:       cfg.Cache.Redis.DB,
+		}), cfg.Cache.Redis.Prefix)
+		defer redisCache.Close()
+		// Недоступный Redis не мешает запуску: поиск идет напрямую в источники
+		pingCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		if err := redisCache.Ping(pingCtx); err != nil {
+			logger.Warn("Redis недоступен, поиск будет работать без кэша до восстановления", zap.String("addr", cfg.Cache.Redis.Addr), zap.Error(err))
+		}
+		cancel()
+		searchCache = redisCache
+	case config.CacheMemory:
+		searchCache = cache.NewMemory()
+	}
+	logger.Info("Кэш результатов поиска", zap.String("backend", cfg.Cache.Backend))
+
+	// Регистрируем источники, описанные в конфигурации
+	registry := connector.NewRegistry()
+	for _, name := range cfg.ConnectorNames() {
+		cc := cfg.Connectors[name]
+		newConnector, ok := connectors[name]
+		if !ok {
+			logger.Fatal("Неизвестный источник в конфигурации", zap.String("connector", name))
+		}
+
+		limits := connector.Limits{
+			Concurrency:   cc.Concurrency,
+			RatePerSecond: cc.RatePerSecond,
+			Burst:         cc.Burst,
+			Timeout:       cc.Timeout,
+		}
+		var wrappers []func(connector.Connector) connector.Connector
+		if searchCache != nil {
+			ttl, staleTTL := cfg.CachePolicy(name)
+			policy := cache.Policy{TTL: ttl, StaleTTL: staleTTL, FetchTimeout: cc.Timeout}
+			wrappers = append(wrappers, func(c connector.Connector) connector.Connector {
+				return cache.Wrap(c, searchCache, policy, logger)
+			})
+		}
+		if err := registry.Register(newConnector(cc), limits, wrappers...); err != nil {
+			logger.Fatal("Ошибка регистрации источника", zap.String("connector", name), zap.Error(err))
+		}
+		if err := registry.SetEnabled(name, cc.Enabled); err != nil {
+			logger.Fatal("Ошибка регистрации источника", zap.String("connector", name), zap.Error(err))
+		}
+		logger.Info("Источник зарегистрирован", zap.String("connector", name), zap.Bool("enabled", cc.Enabled))
+	}
+	if len(registry.Enabled()) == 0 {
+		logger.Warn("Нет включенных источников, поиск будет отвечать 503")
+	}
+
+	search := aggregator.New(registry, cfg.SearchTimeout, logger)
+	searchHandler := delivery.NewSearchHandler(search, logger)
+	importHandler := delivery.NewImportHandler(search, logger)
+	healthHandler := delivery.NewHealthHandler()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /search", searchHandler.Search)
+	mux.HandleFunc("GET /products/{id}/offers/preview", importHandler.Preview)
+	mux.HandleFunc("GET /healthz", healthHandler.Healthz)
+	mux.HandleFunc("GET /readyz", healthHandler.Readyz)
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.HTTP.Port),
+		Handler: mux,
+	}
+
+	// Останавливаемся по SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		logger.Info("Сервер запущен", zap.String("addr", server.Addr))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal("Ошибка при запуске сервера", zap.Error(err))
+		}
+	}()
+
+	<-ctx.Done()
+	logger.Info("Получен сигнал завершения, останавливаем сервер", zap.Duration("timeout", cfg.ShutdownTimeout))
+	healthHandler.SetShuttingDown()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	// Дожидаемся завершения активных запросов
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error("Не удалось дождаться завершения запросов", zap.Error(err))
+	}
+	logger.Info("Сервер остановлен")
+}
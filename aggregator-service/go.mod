@@ -0,0 +1,18 @@
+module aggregator-service
+
+go 1.23.4
+
+require (
+	github.com/redis/go-redis/v9 v9.7.3
+	go.uber.org/zap v1.27.0
+	golang.org/x/net v0.34.0
+	golang.org/x/sync v0.10.0
+	golang.org/x/time v0.11.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+)
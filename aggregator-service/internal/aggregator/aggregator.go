@@ -0,0 +1,155 @@
+// Package aggregator - параллельный поиск по всем включенным источникам с объединением результатов
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"aggregator-service/internal/connector"
+	"aggregator-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrEmptyQuery - не задан поисковый запрос
+	ErrEmptyQuery = errors.New("поисковый запрос не может быть пустым")
+	// ErrNoSources - нет включенных источников
+	ErrNoSources = errors.New("нет включенных источников")
+	// ErrAllSourcesFailed - ни один источник не ответил
+	ErrAllSourcesFailed = errors.New("ни один источник не ответил")
+)
+
+// SourceResult - итог поиска в одном источнике
+type SourceResult struct {
+	Name     string
+	Count    int
+	Duration time.Duration
+	// Err - ошибка источника; остальные источники при этом продолжают работу
+	Err error
+}
+
+// Result - объединенный результат поиска
+type Result struct {
+	// Offers - предложения всех источников по возрастанию цены
+	Offers  []models.Offer
+	Sources []SourceResult
+}
+
+// Aggregator - поиск по всем включенным источникам реестра
+type Aggregator struct {
+	registry *connector.Registry
+	// timeout - общее время поиска; источники, не успевшие ответить, попадают в результат с ошибкой
+	timeout time.Duration
+	logger  *zap.Logger
+	now     func() time.Time
+}
+
+// New - конструктор агрегатора, timeout 0 - без общего ограничения времени
+func New(registry *connector.Registry, timeout time.Duration, logger *zap.Logger) *Aggregator {
+	return &Aggregator{registry: registry, timeout: timeout, logger: logger, now: time.Now}
+}
+
+// Search - параллельный поиск по всем включенным источникам.
+// Ошибка возвращается, только если ни один источник не ответил; ошибки отдельных источников - в Result.Sources.
+func (a *Aggregator) Search(ctx context.Context, query string, vehicle *models.Vehicle) (*Result, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrEmptyQuery
+	}
+	connectors := a.registry.Enabled()
+	if len(connectors) == 0 {
+		return nil, ErrNoSources
+	}
+
+	if a.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.timeout)
+		defer cancel()
+	}
+
+	sources := make([]SourceResult, len(connectors))
+	offers := make([][]models.Offer, len(connectors))
+
+	var wg sync.WaitGroup
+	for i, c := range connectors {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := a.now()
+			found, err := c.Search(ctx, query, vehicle)
+			sources[i] = SourceResult{Name: c.Name(), Count: len(found), Duration: a.now().Sub(start), Err: err}
+			if err != nil {
+				a.logger.Warn("Источник не ответил", zap.String("source", c.Name()), zap.Error(err))
+				return
+			}
+			offers[i] = normalize(c.Name(), found, start)
+		}()
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, s := range sources {
+		if s.Err != nil {
+			failed++
+		}
+	}
+	if failed == len(sources) {
+		errs := make([]error, 0, len(sources))
+		for _, s := range sources {
+			errs = append(errs, s.Err)
+		}
+		return nil, errors.Join(append([]error{ErrAllSourcesFailed}, errs...)...)
+	}
+
+	return &Result{Offers: merge(offers), Sources: sources}, nil
+}
+
+// normalize - заполнение источника и времени получения, если коннектор их не выставил
+func normalize(source string, offers []models.Offer, fetchedAt time.Time) []models.Offer {
+	for i := range offers {
+		if offers[i].Source == "" {
+			offers[i].Source = source
+		}
+		if offers[i].FetchedAt.IsZero() {
+			offers[i].FetchedAt = fetchedAt
+		}
+	}
+	return offers
+}
+
+// merge - объединение предложений без повторов по паре источник-идентификатор, по возрастанию цены.
+// Предложения без цены (договорная) идут последними, с одинаковой ценой - по источнику, чтобы выдача была стабильной.
+func merge(groups [][]models.Offer) []models.Offer {
+	type key struct{ source, id string }
+	seen := make(map[key]bool)
+
+	merged := []models.Offer{}
+	for _, group := range groups {
+		for _, offer := range group {
+			if offer.ExternalID != "" {
+				k := key{offer.Source, offer.ExternalID}
+				if seen[k] {
+					continue
+				}
+				seen[k] = true
+			}
+			merged = append(merged, offer)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		if (merged[i].Price == 0) != (merged[j].Price == 0) {
+			return merged[j].Price == 0
+		}
+		if merged[i].Price != merged[j].Price {
+			return merged[i].Price < merged[j].Price
+		}
+		return merged[i].Source < merged[j].Source
+	})
+	return merged
+}
@@ -0,0 +1,220 @@
+package aggregator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"aggregator-service/internal/connector"
+	"aggregator-service/internal/connector/connectortest"
+	"aggregator-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// jsonConnector - тестовый источник с JSON API, запросы уходят на stand-in сервер
+type jsonConnector struct {
+	name    string
+	baseURL string
+}
+
+func (c *jsonConnector) Name() string { return c.name }
+
+func (c *jsonConnector) Search(ctx context.Context, query string, vehicle *models.Vehicle) ([]models.Offer, error) {
+	params := url.Values{"q": {query}}
+	if vehicle != nil {
+		params.Set("make", vehicle.Make)
+		params.Set("model", vehicle.Model)
+	}
+	body, err := connector.Fetch(ctx, http.DefaultClient, c.baseURL+"/search?"+params.Encode(), "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Items []struct {
+			ID       string  `json:"id"`
+			Title    string  `json:"title"`
+			Brand    string  `json:"brand"`
+			Article  string  `json:"article"`
+			Price    float64 `json:"price"`
+			Currency string  `json:"currency"`
+			URL      string  `json:"url"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	offers := make([]models.Offer, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		offers = append(offers, models.Offer{
+			ExternalID: item.ID,
+			Title:      item.Title,
+			Brand:      item.Brand,
+			Article:    item.Article,
+			Price:      item.Price,
+			Currency:   item.Currency,
+			URL:        item.URL,
+		})
+	}
+	return offers, nil
+}
+
+// blockingConnector - источник, отвечающий только по отмене контекста
+type blockingConnector struct{ name string }
+
+func (c *blockingConnector) Name() string { return c.name }
+
+func (c *blockingConnector) Search(ctx context.Context, _ string, _ *models.Vehicle) ([]models.Offer, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func fixtureServer(t *testing.T, fixture string) *connectortest.Server {
+	t.Helper()
+	return connectortest.NewServer(t, map[string]connectortest.Route{
+		"/search": {Fixture: fixture, ContentType: "application/json"},
+	})
+}
+
+func newAggregator(t *testing.T, timeout time.Duration, connectors ...connector.Connector) *Aggregator {
+	t.Helper()
+	registry := connector.NewRegistry()
+	for _, c := range connectors {
+		if err := registry.Register(c, connector.Limits{}); err != nil {
+			t.Fatalf("Register(%s): %v", c.Name(), err)
+		}
+	}
+	return New(registry, timeout, zap.NewNop())
+}
+
+func TestSearchMergesSources(t *testing.T) {
+	alpha := fixtureServer(t, "testdata/alpha_search.json")
+	beta := fixtureServer(t, "testdata/beta_search.json")
+	agg := newAggregator(t, time.Second,
+		&jsonConnector{name: "alpha", baseURL: alpha.URL},
+		&jsonConnector{name: "beta", baseURL: beta.URL},
+	)
+
+	result, err := agg.Search(context.Background(), "  04152-YZZA1 ", &models.Vehicle{Make: "Toyota", Model: "Camry"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+
+	// Повтор a-101 отбрасывается, предложение без цены идет последним
+	want := []struct {
+		source, id string
+		price      float64
+	}{
+		{"alpha", "a-102", 640},
+		{"beta", "b-7", 750},
+		{"alpha", "a-101", 890},
+		{"beta", "b-8", 0},
+	}
+	if len(result.Offers) != len(want) {
+		t.Fatalf("получено %d предложений, ожидалось %d: %+v", len(result.Offers), len(want), result.Offers)
+	}
+	for i, w := range want {
+		got := result.Offers[i]
+		if got.Source != w.source || got.ExternalID != w.id || got.Price != w.price {
+			t.Errorf("предложение %d = %s/%s %.0f, ожидалось %s/%s %.0f", i, got.Source, got.ExternalID, got.Price, w.source, w.id, w.price)
+		}
+		if got.FetchedAt.IsZero() {
+			t.Errorf("предложение %d: не заполнено время получения", i)
+		}
+	}
+
+	for _, s := range result.Sources {
+		if s.Err != nil {
+			t.Errorf("источник %s: неожиданная ошибка %v", s.Name, s.Err)
+		}
+	}
+
+	requests := alpha.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("alpha получил %d запросов, ожидался 1", len(requests))
+	}
+	if q := requests[0].URL.Query(); q.Get("q") != "04152-YZZA1" || q.Get("make") != "Toyota" || q.Get("model") != "Camry" {
+		t.Errorf("alpha получил параметры %v", q)
+	}
+}
+
+func TestSearchKeepsResultsWhenSourceFails(t *testing.T) {
+	alpha := fixtureServer(t, "testdata/alpha_search.json")
+	broken := connectortest.NewServer(t, map[string]connectortest.Route{
+		"/search": {Status: http.StatusInternalServerError},
+	})
+	agg := newAggregator(t, time.Second,
+		&jsonConnector{name: "alpha", baseURL: alpha.URL},
+		&jsonConnector{name: "broken", baseURL: broken.URL},
+	)
+
+	result, err := agg.Search(context.Background(), "фильтр", nil)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(result.Offers) != 2 {
+		t.Errorf("получено %d предложений, ожидалось 2", len(result.Offers))
+	}
+
+	var statusErr *connector.StatusError
+	for _, s := range result.Sources {
+		if s.Name == "broken" && !errors.As(s.Err, &statusErr) {
+			t.Errorf("broken: ошибка %v, ожидалась StatusError", s.Err)
+		}
+	}
+	if statusErr == nil || statusErr.Code != http.StatusInternalServerError {
+		t.Errorf("код ответа broken = %+v, ожидался 500", statusErr)
+	}
+}
+
+func TestSearchTimesOutSlowSource(t *testing.T) {
+	alpha := fixtureServer(t, "testdata/alpha_search.json")
+	agg := newAggregator(t, 50*time.Millisecond,
+		&jsonConnector{name: "alpha", baseURL: alpha.URL},
+		&blockingConnector{name: "slow"},
+	)
+
+	start := time.Now()
+	result, err := agg.Search(context.Background(), "фильтр", nil)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("поиск занял %v, медленный источник не был прерван", elapsed)
+	}
+	if len(result.Offers) != 2 {
+		t.Errorf("получено %d предложений, ожидалось 2", len(result.Offers))
+	}
+	for _, s := range result.Sources {
+		if s.Name == "slow" && !errors.Is(s.Err, context.DeadlineExceeded) {
+			t.Errorf("slow: ошибка %v, ожидался DeadlineExceeded", s.Err)
+		}
+	}
+}
+
+func TestSearchAllSourcesFailed(t *testing.T) {
+	agg := newAggregator(t, 20*time.Millisecond, &blockingConnector{name: "slow"})
+
+	_, err := agg.Search(context.Background(), "фильтр", nil)
+	if !errors.Is(err, ErrAllSourcesFailed) {
+		t.Fatalf("ошибка %v, ожидалась ErrAllSourcesFailed", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ошибка %v не содержит причину отказа источника", err)
+	}
+}
+
+func TestSearchRejectsInvalidInput(t *testing.T) {
+	if _, err := newAggregator(t, time.Second, &blockingConnector{name: "slow"}).Search(context.Background(), "   ", nil); !errors.Is(err, ErrEmptyQuery) {
+		t.Errorf("пустой запрос: ошибка %v, ожидалась ErrEmptyQuery", err)
+	}
+	if _, err := newAggregator(t, time.Second).Search(context.Background(), "фильтр", nil); !errors.Is(err, ErrNoSources) {
+		t.Errorf("без источников: ошибка %v, ожидалась ErrNoSources", err)
+	}
+}
@@ -0,0 +1,59 @@
+// Package cache - кэш результатов поиска в источниках
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"aggregator-service/internal/models"
+)
+
+// ErrMiss - записи нет в кэше или ее время хранения истекло
+var ErrMiss = errors.New("запись не найдена в кэше")
+
+// Entry - сохраненный результат поиска в одном источнике
+type Entry struct {
+	Offers []models.Offer `json:"offers"`
+	// FetchedAt - время получения результата из источника, от него отсчитывается свежесть
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// Cache - хранилище результатов поиска
+type Cache interface {
+	// Get - запись по ключу или ErrMiss
+	Get(ctx context.Context, key string) (Entry, error)
+	// Set - сохранение записи; по истечении ttl запись удаляется
+	Set(ctx context.Context, key string, entry Entry, ttl time.Duration) error
+}
+
+// Key - ключ результата поиска в источнике.
+// Запрос и автомобиль приводятся к нижнему регистру со схлопнутыми пробелами, поэтому
+// "Фильтр  масляный" и "фильтр масляный" попадают в одну запись.
+func Key(source, query string, vehicle *models.Vehicle) string {
+	parts := []string{normalize(query)}
+	if vehicle != nil {
+		parts = append(parts, normalize(vehicle.Make), normalize(vehicle.Model))
+		if vehicle.Year > 0 {
+			parts = append(parts, strconv.Itoa(vehicle.Year))
+		}
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return "search:" + source + ":" + hex.EncodeToString(sum[:16])
+}
+
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// cloneOffers - копия предложений, чтобы вызывающий мог менять их, не затрагивая кэш и других читателей
+func cloneOffers(offers []models.Offer) []models.Offer {
+	if offers == nil {
+		return nil
+	}
+	return append(make([]models.Offer, 0, len(offers)), offers...)
+}
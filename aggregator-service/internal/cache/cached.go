@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"aggregator-service/internal/connector"
+	"aggregator-service/internal/models"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultFetchTimeout - время на загрузку из источника, если в политике оно не задано
+const DefaultFetchTimeout = 30 * time.Second
+
+// Policy - политика кэширования результатов источника
+type Policy struct {
+	// TTL - время, в течение которого результат считается свежим
+	TTL time.Duration
+	// StaleTTL - время после TTL, в течение которого отдается устаревший результат,
+	// а источник опрашивается в фоне. 0 - устаревший результат не отдается.
+	StaleTTL time.Duration
+	// FetchTimeout - время на загрузку из источника при промахе и фоновом обновлении
+	FetchTimeout time.Duration
+}
+
+// cached - источник с кэшированием результатов
+type cached struct {
+	connector.Connector
+	cache  Cache
+	policy Policy
+	group  singleflight.Group
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// Wrap - обертка источника, кэширующая его результаты по политике.
+// Одновременные одинаковые запросы при промахе или обновлении приводят к одному запросу в источник.
+// Ошибки источника не кэшируются; ошибки самого кэша только логируются, и запрос уходит в источник.
+func Wrap(c connector.Connector, cache Cache, policy Policy, logger *zap.Logger) connector.Connector {
+	if policy.FetchTimeout <= 0 {
+		policy.FetchTimeout = DefaultFetchTimeout
+	}
+	return &cached{Connector: c, cache: cache, policy: policy, logger: logger, now: time.Now}
+}
+
+// Search - результат из кэша или из источника
+func (c *cached) Search(ctx context.Context, query string, vehicle *models.Vehicle) ([]models.Offer, error) {
+	key := Key(c.Name(), query, vehicle)
+
+	entry, err := c.cache.Get(ctx, key)
+	switch {
+	case err == nil:
+		if c.now().Sub(entry.FetchedAt) >= c.policy.TTL {
+			// Устаревший результат отдается сразу, свежий загружается в фоне для следующих запросов
+			c.group.DoChan(key, func() (any, error) {
+				return c.load(ctx, key, query, vehicle)
+			})
+		}
+		return entry.Offers, nil
+	case !errors.Is(err, ErrMiss):
+		c.logger.Warn("Ошибка чтения кэша", zap.String("source", c.Name()), zap.Error(err))
+	}
+
+	ch := c.group.DoChan(key, func() (any, error) {
+		return c.load(ctx, key, query, vehicle)
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return cloneOffers(res.Val.([]models.Offer)), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// load - запрос в источник и сохранение результата.
+// Загрузку ждут все совпавшие запросы, поэтому она не прерывается отменой запроса, который ее начал.
+func (c *cached) load(ctx context.Context, key, query string, vehicle *models.Vehicle) ([]models.Offer, error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.policy.FetchTimeout)
+	defer cancel()
+
+	fetchedAt := c.now()
+	offers, err := c.Connector.Search(ctx, query, vehicle)
+	if err != nil {
+		return nil, err
+	}
+	for i := range offers {
+		if offers[i].FetchedAt.IsZero() {
+			offers[i].FetchedAt = fetchedAt
+		}
+	}
+
+	entry := Entry{Offers: offers, FetchedAt: fetchedAt}
+	if err := c.cache.Set(ctx, key, entry, c.policy.TTL+c.policy.StaleTTL); err != nil {
+		c.logger.Warn("Ошибка записи в кэш", zap.String("source", c.Name()), zap.Error(err))
+	}
+	return offers, nil
+}
@@ -0,0 +1,236 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"aggregator-service/internal/connector"
+	"aggregator-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// clock - управляемое время для проверки свежести
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// countingConnector - источник, возвращающий номер вызова в цене предложения
+type countingConnector struct {
+	calls   atomic.Int32
+	err     error
+	release chan struct{}
+}
+
+func (f *countingConnector) Name() string { return "counting" }
+
+func (f *countingConnector) Search(ctx context.Context, _ string, _ *models.Vehicle) ([]models.Offer, error) {
+	n := f.calls.Add(1)
+	if f.release != nil {
+		<-f.release
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []models.Offer{{ExternalID: "1", Price: float64(n)}}, nil
+}
+
+func newCached(t *testing.T, c connector.Connector, policy Policy) (connector.Connector, *clock) {
+	t.Helper()
+	clk := &clock{now: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mem := NewMemory()
+	mem.now = clk.Now
+	wrapped := Wrap(c, mem, policy, zap.NewNop())
+	wrapped.(*cached).now = clk.Now
+	return wrapped, clk
+}
+
+func search(t *testing.T, c connector.Connector, query string) float64 {
+	t.Helper()
+	offers, err := c.Search(context.Background(), query, &models.Vehicle{Make: "Toyota", Model: "Camry"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(offers) != 1 {
+		t.Fatalf("предложений %d, ожидалось 1", len(offers))
+	}
+	return offers[0].Price
+}
+
+func waitCalls(t *testing.T, f *countingConnector, want int32) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for f.calls.Load() < want {
+		if time.Now().After(deadline) {
+			t.Fatalf("вызовов источника %d, ожидалось %d", f.calls.Load(), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCachedFreshHit(t *testing.T) {
+	fake := &countingConnector{}
+	c, _ := newCached(t, fake, Policy{TTL: time.Minute})
+
+	search(t, c, "фильтр масляный")
+	search(t, c, "  Фильтр   МАСЛЯНЫЙ ")
+	if n := fake.calls.Load(); n != 1 {
+		t.Errorf("вызовов источника %d, ожидался 1: нормализованный запрос должен попасть в кэш", n)
+	}
+
+	search(t, c, "фильтр воздушный")
+	if n := fake.calls.Load(); n != 2 {
+		t.Errorf("вызовов источника %d, ожидалось 2 для другого запроса", n)
+	}
+}
+
+func TestCachedStaleWhileRevalidate(t *testing.T) {
+	fake := &countingConnector{}
+	c, clk := newCached(t, fake, Policy{TTL: time.Minute, StaleTTL: 10 * time.Minute})
+
+	if price := search(t, c, "фильтр"); price != 1 {
+		t.Fatalf("цена %v, ожидался первый ответ источника", price)
+	}
+
+	clk.Advance(2 * time.Minute)
+	if price := search(t, c, "фильтр"); price != 1 {
+		t.Errorf("цена %v, ожидался устаревший результат без ожидания источника", price)
+	}
+	waitCalls(t, fake, 2)
+
+	// Фоновое обновление записывает результат в кэш после ответа источника
+	deadline := time.Now().Add(time.Second)
+	for search(t, c, "фильтр") != 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("обновленный результат не попал в кэш")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if n := fake.calls.Load(); n != 2 {
+		t.Errorf("вызовов источника %d, ожидалось 2", n)
+	}
+}
+
+func TestCachedExpiredAfterStaleTTL(t *testing.T) {
+	fake := &countingConnector{}
+	c, clk := newCached(t, fake, Policy{TTL: time.Minute, StaleTTL: 10 * time.Minute})
+
+	search(t, c, "фильтр")
+	clk.Advance(12 * time.Minute)
+	if price := search(t, c, "фильтр"); price != 2 {
+		t.Errorf("цена %v, ожидался новый ответ источника после истечения stale_ttl", price)
+	}
+}
+
+func TestCachedCoalescesConcurrentSearches(t *testing.T) {
+	fake := &countingConnector{release: make(chan struct{})}
+	c, _ := newCached(t, fake, Policy{TTL: time.Minute})
+
+	const callers = 10
+	var wg sync.WaitGroup
+	prices := make([]float64, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			prices[i] = search(t, c, "фильтр")
+		}()
+	}
+
+	waitCalls(t, fake, 1)
+	time.Sleep(20 * time.Millisecond)
+	close(fake.release)
+	wg.Wait()
+
+	if n := fake.calls.Load(); n != 1 {
+		t.Errorf("вызовов источника %d, ожидался 1 на все одновременные запросы", n)
+	}
+	for i, price := range prices {
+		if price != 1 {
+			t.Errorf("prices[%d] = %v, ожидался общий результат", i, price)
+		}
+	}
+}
+
+func TestCachedLoadOutlivesCanceledCaller(t *testing.T) {
+	fake := &countingConnector{release: make(chan struct{})}
+	c, _ := newCached(t, fake, Policy{TTL: time.Minute})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := c.Search(ctx, "фильтр", nil)
+		leaderErr <- err
+	}()
+	waitCalls(t, fake, 1)
+
+	follower := make(chan []models.Offer, 1)
+	go func() {
+		offers, _ := c.Search(context.Background(), "фильтр", nil)
+		follower <- offers
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("ошибка %v, ожидалась отмена для отмененного запроса", err)
+	}
+	close(fake.release)
+
+	if offers := <-follower; len(offers) != 1 {
+		t.Errorf("предложений %d: загрузка не должна прерываться отменой первого запроса", len(offers))
+	}
+	if n := fake.calls.Load(); n != 1 {
+		t.Errorf("вызовов источника %d, ожидался 1", n)
+	}
+}
+
+func TestCachedErrorsAreNotCached(t *testing.T) {
+	fake := &countingConnector{err: errors.New("источник недоступен")}
+	c, _ := newCached(t, fake, Policy{TTL: time.Minute})
+
+	for range 2 {
+		if _, err := c.Search(context.Background(), "фильтр", nil); err == nil {
+			t.Fatal("ожидалась ошибка источника")
+		}
+	}
+	if n := fake.calls.Load(); n != 2 {
+		t.Errorf("вызовов источника %d, ожидалось 2: ошибка не должна кэшироваться", n)
+	}
+}
+
+func TestKey(t *testing.T) {
+	vehicle := &models.Vehicle{Make: "Toyota", Model: "Camry", Year: 2008}
+	if Key("drom", "Фильтр  масляный", vehicle) != Key("drom", "фильтр масляный", &models.Vehicle{Make: "TOYOTA", Model: "camry", Year: 2008}) {
+		t.Error("ключи нормализованных запросов должны совпадать")
+	}
+	for _, other := range []string{
+		Key("exist", "фильтр масляный", vehicle),
+		Key("drom", "фильтр масляный", nil),
+		Key("drom", "фильтр масляный", &models.Vehicle{Make: "Toyota", Model: "Camry", Year: 2010}),
+	} {
+		if other == Key("drom", "фильтр масляный", vehicle) {
+			t.Errorf("ключ %s совпал с ключом другого поиска", other)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Memory - кэш в памяти процесса, для тестов и запуска без Redis
+type Memory struct {
+	mu    sync.Mutex
+	items map[string]memoryItem
+	now   func() time.Time
+}
+
+type memoryItem struct {
+	entry     Entry
+	expiresAt time.Time
+}
+
+// NewMemory - конструктор кэша в памяти
+func NewMemory() *Memory {
+	return &Memory{items: make(map[string]memoryItem), now: time.Now}
+}
+
+// Get - запись по ключу; истекшая запись удаляется при обращении
+func (m *Memory) Get(_ context.Context, key string) (Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.items[key]
+	if !ok {
+		return Entry{}, ErrMiss
+	}
+	if !m.now().Before(item.expiresAt) {
+		delete(m.items, key)
+		return Entry{}, ErrMiss
+	}
+	entry := item.entry
+	entry.Offers = cloneOffers(entry.Offers)
+	return entry, nil
+}
+
+// Set - сохранение записи на ttl
+func (m *Memory) Set(_ context.Context, key string, entry Entry, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	// Истекшие записи удаляются и при записи, чтобы кэш не рос от ключей, к которым больше не обращаются
+	for k, item := range m.items {
+		if !now.Before(item.expiresAt) {
+			delete(m.items, k)
+		}
+	}
+
+	entry.Offers = cloneOffers(entry.Offers)
+	m.items[key] = memoryItem{entry: entry, expiresAt: now.Add(ttl)}
+	return nil
+}
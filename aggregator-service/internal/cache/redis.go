@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis - кэш в Redis, общий для всех экземпляров агрегатора.
+// Записи хранятся в JSON, время хранения выставляется самим Redis.
+type Redis struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedis - конструктор кэша; prefix добавляется ко всем ключам
+func NewRedis(client *redis.Client, prefix string) *Redis {
+	return &Redis{client: client, prefix: prefix}
+}
+
+// Get - запись по ключу
+func (r *Redis) Get(ctx context.Context, key string) (Entry, error) {
+	data, err := r.client.Get(ctx, r.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return Entry{}, ErrMiss
+	}
+	if err != nil {
+		return Entry{}, err
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return Entry{}, fmt.Errorf("запись %s в кэше повреждена: %w", key, err)
+	}
+	return entry, nil
+}
+
+// Set - сохранение записи на ttl
+func (r *Redis) Set(ctx context.Context, key string, entry Entry, ttl time.Duration) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, r.prefix+key, data, ttl).Err()
+}
+
+// Ping - проверка доступности Redis
+func (r *Redis) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
+// Close - закрытие соединений с Redis
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
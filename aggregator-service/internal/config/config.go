@@ -0,0 +1,266 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config - конфигурация агрегатора
+type Config struct {
+	HTTP HTTPConfig `yaml:"http"`
+	// SearchTimeout - общее время поиска по всем источникам
+	SearchTimeout time.Duration `yaml:"search_timeout"`
+	Cache         CacheConfig   `yaml:"cache"`
+	// Connectors - параметры источников по имени
+	Connectors map[string]ConnectorConfig `yaml:"connectors"`
+	// ShutdownTimeout - время на завершение активных запросов при остановке
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// HTTPConfig - параметры HTTP-сервера
+type HTTPConfig struct {
+	Port int `yaml:"port"`
+}
+
+// Хранилища кэша результатов поиска
+const (
+	CacheNone   = "none"
+	CacheMemory = "memory"
+	CacheRedis  = "redis"
+)
+
+// CacheConfig - параметры кэша результатов поиска
+type CacheConfig struct {
+	// Backend - none, memory или redis
+	Backend string `yaml:"backend"`
+	// TTL - время свежести результата источника, переопределяется connectors.<имя>.cache_ttl
+	TTL time.Duration `yaml:"ttl"`
+	// StaleTTL - время после TTL, в течение которого отдается устаревший результат с фоновым обновлением
+	StaleTTL time.Duration `yaml:"stale_ttl"`
+	Redis    RedisConfig   `yaml:"redis"`
+}
+
+// RedisConfig - параметры подключения к Redis
+type RedisConfig struct {
+	Addr     string `yaml:"addr"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+	// Prefix - префикс ключей кэша
+	Prefix string `yaml:"prefix"`
+}
+
+// ConnectorConfig - параметры источника
+type ConnectorConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// BaseURL - адрес источника, пустой - адрес по умолчанию коннектора
+	BaseURL string `yaml:"base_url"`
+	// Concurrency - число одновременных запросов, 0 - без ограничения
+	Concurrency int `yaml:"concurrency"`
+	// RatePerSecond - частота запросов, 0 - без ограничения
+	RatePerSecond float64 `yaml:"rate_per_second"`
+	Burst         int     `yaml:"burst"`
+	// Timeout - время на один поиск в источнике
+	Timeout time.Duration `yaml:"timeout"`
+	// MaxPages - число просматриваемых страниц выдачи для источников с постраничным списком, 0 - по умолчанию коннектора
+	MaxPages int `yaml:"max_pages"`
+	// CacheTTL, CacheStaleTTL - время свежести результатов источника, 0 - значения из cache
+	CacheTTL      time.Duration `yaml:"cache_ttl"`
+	CacheStaleTTL time.Duration `yaml:"cache_stale_ttl"`
+}
+
+// Load - загрузка конфигурации.
+// Порядок применения: значения по умолчанию, YAML-файл из CONFIG_PATH (если задан), переменные окружения.
+func Load() (*Config, error) {
+	cfg := &Config{
+		HTTP: HTTPConfig{
+			Port: 9094,
+		},
+		SearchTimeout: 10 * time.Second,
+		Cache: CacheConfig{
+			Backend:  CacheMemory,
+			TTL:      10 * time.Minute,
+			StaleTTL: time.Hour,
+			Redis: RedisConfig{
+				Addr:   "localhost:6379",
+				Prefix: "aggregator:",
+			},
+		},
+		Connectors:      map[string]ConnectorConfig{},
+		ShutdownTimeout: 15 * time.Second,
+	}
+
+	if path := os.Getenv("CONFIG_PATH"); path != "" {
+		if err := loadYAML(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("невалидная конфигурация: %w", err)
+	}
+	return cfg, nil
+}
+
+// Validate - проверка корректности конфигурации
+func (c *Config) Validate() error {
+	var errs []error
+	if c.HTTP.Port <= 0 || c.HTTP.Port > 65535 {
+		errs = append(errs, fmt.Errorf("http.port (HTTP_PORT): порт %d вне диапазона 1-65535", c.HTTP.Port))
+	}
+	if c.SearchTimeout <= 0 {
+		errs = append(errs, errors.New("search_timeout (SEARCH_TIMEOUT): время должно быть положительным"))
+	}
+	switch c.Cache.Backend {
+	case CacheNone, CacheMemory:
+	case CacheRedis:
+		if c.Cache.Redis.Addr == "" {
+			errs = append(errs, errors.New("cache.redis.addr (REDIS_ADDR): значение не задано"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("cache.backend (CACHE_BACKEND): ожидалось %s, %s или %s, получено %q",
+			CacheNone, CacheMemory, CacheRedis, c.Cache.Backend))
+	}
+	if c.Cache.TTL <= 0 {
+		errs = append(errs, errors.New("cache.ttl (CACHE_TTL): время должно быть положительным"))
+	}
+	if c.Cache.StaleTTL < 0 {
+		errs = append(errs, errors.New("cache.stale_ttl (CACHE_STALE_TTL): время не может быть отрицательным"))
+	}
+	for _, name := range c.ConnectorNames() {
+		cc := c.Connectors[name]
+		if cc.Concurrency < 0 {
+			errs = append(errs, fmt.Errorf("connectors.%s.concurrency: значение не может быть отрицательным", name))
+		}
+		if cc.RatePerSecond < 0 {
+			errs = append(errs, fmt.Errorf("connectors.%s.rate_per_second: значение не может быть отрицательным", name))
+		}
+		if cc.Burst < 0 {
+			errs = append(errs, fmt.Errorf("connectors.%s.burst: значение не может быть отрицательным", name))
+		}
+		if cc.Timeout < 0 {
+			errs = append(errs, fmt.Errorf("connectors.%s.timeout: время не может быть отрицательным", name))
+		}
+		if cc.MaxPages < 0 {
+			errs = append(errs, fmt.Errorf("connectors.%s.max_pages: значение не может быть отрицательным", name))
+		}
+		if cc.CacheTTL < 0 || cc.CacheStaleTTL < 0 {
+			errs = append(errs, fmt.Errorf("connectors.%s.cache_ttl, cache_stale_ttl: время не может быть отрицательным", name))
+		}
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown_timeout (SHUTDOWN_TIMEOUT): время должно быть положительным"))
+	}
+	return errors.Join(errs...)
+}
+
+// CachePolicy - время свежести и устаревания результатов источника с учетом его переопределений
+func (c *Config) CachePolicy(name string) (ttl, staleTTL time.Duration) {
+	ttl, staleTTL = c.Cache.TTL, c.Cache.StaleTTL
+	if cc := c.Connectors[name]; cc.CacheTTL > 0 {
+		ttl = cc.CacheTTL
+	}
+	if cc := c.Connectors[name]; cc.CacheStaleTTL > 0 {
+		staleTTL = cc.CacheStaleTTL
+	}
+	return ttl, staleTTL
+}
+
+// ConnectorNames - имена источников из конфигурации по алфавиту
+func (c *Config) ConnectorNames() []string {
+	names := make([]string, 0, len(c.Connectors))
+	for name := range c.Connectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadEnv - переопределение параметров переменными окружения.
+// ENABLED_CONNECTORS - список включенных источников через запятую, остальные отключаются.
+func (c *Config) loadEnv() error {
+	if value, ok := os.LookupEnv("ENABLED_CONNECTORS"); ok {
+		enabled := make(map[string]bool)
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				enabled[name] = true
+			}
+		}
+		for name, cc := range c.Connectors {
+			cc.Enabled = enabled[name]
+			c.Connectors[name] = cc
+			delete(enabled, name)
+		}
+		for name := range enabled {
+			c.Connectors[name] = ConnectorConfig{Enabled: true}
+		}
+	}
+	envString(&c.Cache.Backend, "CACHE_BACKEND")
+	envString(&c.Cache.Redis.Addr, "REDIS_ADDR")
+	envString(&c.Cache.Redis.Password, "REDIS_PASSWORD")
+	return errors.Join(
+		envInt(&c.HTTP.Port, "HTTP_PORT"),
+		envDuration(&c.SearchTimeout, "SEARCH_TIMEOUT"),
+		envDuration(&c.Cache.TTL, "CACHE_TTL"),
+		envDuration(&c.Cache.StaleTTL, "CACHE_STALE_TTL"),
+		envInt(&c.Cache.Redis.DB, "REDIS_DB"),
+		envDuration(&c.ShutdownTimeout, "SHUTDOWN_TIMEOUT"),
+	)
+}
+
+func loadYAML(path string, cfg *Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("не удалось открыть файл конфигурации: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("не удалось разобрать файл конфигурации %s: %w", path, err)
+	}
+	return nil
+}
+
+func envString(dst *string, key string) {
+	if value, ok := os.LookupEnv(key); ok {
+		*dst = value
+	}
+}
+
+func envInt(dst *int, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалось целое число, получено %q", key, value)
+	}
+	*dst = n
+	return nil
+}
+
+func envDuration(dst *time.Duration, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалась длительность (например, 10s), получено %q", key, value)
+	}
+	*dst = d
+	return nil
+}
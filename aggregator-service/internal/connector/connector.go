@@ -0,0 +1,39 @@
+// Package connector - подключаемые источники предложений (маркетплейсы, доски объявлений, API поставщиков)
+// и ограничения обращений к ним.
+package connector
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"aggregator-service/internal/models"
+)
+
+// Connector - источник предложений
+type Connector interface {
+	// Name - уникальное имя источника, используется в конфигурации и в ответах
+	Name() string
+	// Search - поиск предложений по запросу; vehicle может быть nil.
+	// Возвращаемые предложения должны быть нормализованы: заполнены Source, Price и Currency.
+	Search(ctx context.Context, query string, vehicle *models.Vehicle) ([]models.Offer, error)
+}
+
+// Limits - ограничения обращений к источнику
+type Limits struct {
+	// Concurrency - число одновременных запросов, 0 - без ограничения
+	Concurrency int
+	// RatePerSecond - частота запросов, 0 - без ограничения
+	RatePerSecond float64
+	// Burst - допустимый всплеск запросов сверх частоты, не меньше 1
+	Burst int
+	// Timeout - время на один поиск, 0 - без ограничения
+	Timeout time.Duration
+}
+
+var (
+	// ErrDuplicate - источник с таким именем уже зарегистрирован
+	ErrDuplicate = errors.New("источник уже зарегистрирован")
+	// ErrUnknown - источник не зарегистрирован
+	ErrUnknown = errors.New("неизвестный источник")
+)
@@ -0,0 +1,82 @@
+// Package connectortest - stand-in источника для офлайн-тестов коннекторов:
+// httptest-сервер, отдающий записанные страницы и ответы API из testdata.
+package connectortest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// Route - ответ stand-in сервера на путь запроса
+type Route struct {
+	// Fixture - путь к файлу с записанным ответом
+	Fixture string
+	// ContentType - заголовок Content-Type ответа
+	ContentType string
+	// Status - код ответа, по умолчанию 200
+	Status int
+}
+
+// Server - stand-in источника
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+// NewServer - запуск stand-in сервера; сервер останавливается по завершении теста.
+// Ключ routes - путь с query (например, "/list?page=2") или без него; сначала ищется точное совпадение
+// с путем и query, затем с путем. На неизвестные пути сервер отвечает 404.
+func NewServer(t testing.TB, routes map[string]Route) *Server {
+	t.Helper()
+
+	bodies := make(map[string][]byte, len(routes))
+	for path, route := range routes {
+		if route.Fixture == "" {
+			continue
+		}
+		body, err := os.ReadFile(filepath.Clean(route.Fixture))
+		if err != nil {
+			t.Fatalf("не удалось прочитать fixture %s: %v", route.Fixture, err)
+		}
+		bodies[path] = body
+	}
+
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r.Clone(r.Context()))
+		s.mu.Unlock()
+
+		key := r.URL.RequestURI()
+		route, ok := routes[key]
+		if !ok {
+			key = r.URL.Path
+			if route, ok = routes[key]; !ok {
+				http.NotFound(w, r)
+				return
+			}
+		}
+		if route.ContentType != "" {
+			w.Header().Set("Content-Type", route.ContentType)
+		}
+		if route.Status != 0 {
+			w.WriteHeader(route.Status)
+		}
+		w.Write(bodies[key])
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Requests - принятые сервером запросы в порядке поступления
+func (s *Server) Requests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests...)
+}
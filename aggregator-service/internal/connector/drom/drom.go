@@ -0,0 +1,123 @@
+// Package drom - источник объявлений о продаже запчастей baza.drom.ru
+package drom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"aggregator-service/internal/connector"
+	"aggregator-service/internal/models"
+)
+
+// Name - имя источника в конфигурации и ответах
+const Name = "drom"
+
+// Значения по умолчанию
+const (
+	DefaultBaseURL  = "https://baza.drom.ru"
+	DefaultMaxPages = 3
+)
+
+// Connector - поиск объявлений на baza.drom.ru по страницам списка
+type Connector struct {
+	baseURL  string
+	maxPages int
+	client   *http.Client
+}
+
+// New - конструктор источника; пустой baseURL и maxPages <= 0 заменяются значениями по умолчанию
+func New(baseURL string, maxPages int, client *http.Client) *Connector {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Connector{baseURL: strings.TrimRight(baseURL, "/"), maxPages: maxPages, client: client}
+}
+
+// Name - имя источника
+func (c *Connector) Name() string {
+	return Name
+}
+
+// Search - поиск объявлений, не больше maxPages страниц.
+// Марка и модель автомобиля добавляются к тексту запроса. Объявление, попавшее на несколько страниц, возвращается один раз.
+// Ошибка на второй и следующих страницах не отбрасывает уже найденные объявления.
+func (c *Connector) Search(ctx context.Context, query string, vehicle *models.Vehicle) ([]models.Offer, error) {
+	pageURL := c.searchURL(query, vehicle)
+
+	offers := []models.Offer{}
+	seenListings := make(map[string]bool)
+	seenPages := make(map[string]bool)
+	for page := 0; page < c.maxPages && pageURL != "" && !seenPages[pageURL]; page++ {
+		seenPages[pageURL] = true
+
+		parsed, err := c.fetchPage(ctx, pageURL)
+		if err != nil {
+			if page == 0 {
+				return nil, err
+			}
+			break
+		}
+
+		for _, listing := range parsed.Listings {
+			if seenListings[listing.ID] {
+				continue
+			}
+			seenListings[listing.ID] = true
+			offers = append(offers, toOffer(listing))
+		}
+		pageURL = parsed.NextURL
+	}
+	return offers, nil
+}
+
+// searchURL - адрес первой страницы поиска
+func (c *Connector) searchURL(query string, vehicle *models.Vehicle) string {
+	terms := []string{strings.TrimSpace(query)}
+	if vehicle != nil {
+		terms = append(terms, vehicle.Make, vehicle.Model)
+	}
+	return c.baseURL + "/sell_spare_parts/?query=" + url.QueryEscape(strings.Join(strings.Fields(strings.Join(terms, " ")), " "))
+}
+
+// fetchPage - загрузка и разбор страницы списка
+func (c *Connector) fetchPage(ctx context.Context, pageURL string) (*Page, error) {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, err
+	}
+	body, err := connector.Fetch(ctx, c.client, pageURL, "text/html")
+	if err != nil {
+		return nil, err
+	}
+	page, err := Parse(bytes.NewReader(body), base)
+	if err != nil {
+		return nil, fmt.Errorf("%s: разбор страницы %s: %w", Name, pageURL, err)
+	}
+	return page, nil
+}
+
+// toOffer - приведение объявления к общему виду предложения
+func toOffer(l Listing) models.Offer {
+	return models.Offer{
+		Source:     Name,
+		ExternalID: l.ID,
+		Title:      l.Title,
+		Price:      l.Price,
+		Currency:   "RUB",
+		Condition:  l.Condition,
+		Seller:     l.Seller,
+		Location:   l.City,
+		URL:        l.URL,
+		ImageURL:   l.PhotoURL,
+	}
+}
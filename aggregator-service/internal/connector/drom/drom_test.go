@@ -0,0 +1,114 @@
+package drom
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"aggregator-service/internal/connector"
+	"aggregator-service/internal/connector/connectortest"
+	"aggregator-service/internal/models"
+)
+
+const page2URI = "/novosibirsk/sell_spare_parts/?query=%D1%84%D0%B8%D0%BB%D1%8C%D1%82%D1%80&page=2"
+
+func TestSearchFollowsPagesAndDedupes(t *testing.T) {
+	srv := connectortest.NewServer(t, map[string]connectortest.Route{
+		"/sell_spare_parts/": {Fixture: "testdata/listing_page1.html", ContentType: "text/html; charset=utf-8"},
+		page2URI:             {Fixture: "testdata/listing_page2.html", ContentType: "text/html; charset=utf-8"},
+	})
+
+	offers, err := New(srv.URL, 0, srv.Client()).Search(context.Background(), "фильтр", &models.Vehicle{Make: "Toyota", Model: "Camry"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("запросов %d, ожидалось 2", len(requests))
+	}
+	if q := requests[0].URL.Query().Get("query"); q != "фильтр Toyota Camry" {
+		t.Errorf("query = %q, ожидалось марка и модель в запросе", q)
+	}
+
+	wantIDs := []string{"91234567", "91234568", "91234569", "91234570", "91234571"}
+	if len(offers) != len(wantIDs) {
+		t.Fatalf("предложений %d, ожидалось %d (повтор со второй страницы отбрасывается)", len(offers), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if offers[i].ExternalID != id {
+			t.Errorf("offers[%d].ExternalID = %q, ожидалось %q", i, offers[i].ExternalID, id)
+		}
+		if offers[i].Source != Name || offers[i].Currency != "RUB" {
+			t.Errorf("offers[%d]: source %q, currency %q", i, offers[i].Source, offers[i].Currency)
+		}
+	}
+
+	first := offers[0]
+	if first.Price != 1250 || first.Condition != models.ConditionNew || first.Seller != "АвтоДеталь54" || first.Location != "Новосибирск" {
+		t.Errorf("первое предложение разобрано неверно: %+v", first)
+	}
+	if first.ImageURL == "" || first.URL == "" {
+		t.Errorf("у первого предложения нет ссылок: %+v", first)
+	}
+}
+
+func TestSearchMaxPages(t *testing.T) {
+	srv := connectortest.NewServer(t, map[string]connectortest.Route{
+		"/sell_spare_parts/": {Fixture: "testdata/listing_page1.html"},
+		page2URI:             {Fixture: "testdata/listing_page2.html"},
+	})
+
+	offers, err := New(srv.URL, 1, srv.Client()).Search(context.Background(), "фильтр", nil)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(offers) != 4 {
+		t.Errorf("предложений %d, ожидалось 4 с одной страницы", len(offers))
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("запросов %d, ожидался 1", n)
+	}
+}
+
+func TestSearchKeepsFirstPageWhenNextFails(t *testing.T) {
+	srv := connectortest.NewServer(t, map[string]connectortest.Route{
+		"/sell_spare_parts/": {Fixture: "testdata/listing_page1.html"},
+		page2URI:             {Status: http.StatusServiceUnavailable},
+	})
+
+	offers, err := New(srv.URL, 0, srv.Client()).Search(context.Background(), "фильтр", nil)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(offers) != 4 {
+		t.Errorf("предложений %d, ожидалось 4 с первой страницы", len(offers))
+	}
+}
+
+func TestSearchFirstPageError(t *testing.T) {
+	srv := connectortest.NewServer(t, map[string]connectortest.Route{
+		"/sell_spare_parts/": {Status: http.StatusForbidden},
+	})
+
+	_, err := New(srv.URL, 0, srv.Client()).Search(context.Background(), "фильтр", nil)
+	var statusErr *connector.StatusError
+	if !errors.As(err, &statusErr) || statusErr.Code != http.StatusForbidden {
+		t.Fatalf("ошибка %v, ожидался StatusError 403", err)
+	}
+}
+
+func TestSearchEmpty(t *testing.T) {
+	srv := connectortest.NewServer(t, map[string]connectortest.Route{
+		"/sell_spare_parts/": {Fixture: "testdata/listing_empty.html"},
+	})
+
+	offers, err := New(srv.URL, 0, srv.Client()).Search(context.Background(), "несуществующая деталь", nil)
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(offers) != 0 {
+		t.Errorf("предложений %d, ожидалось 0", len(offers))
+	}
+}
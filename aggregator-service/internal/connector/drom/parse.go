@@ -0,0 +1,200 @@
+package drom
+
+import (
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"aggregator-service/internal/models"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Listing - объявление со страницы списка baza.drom.ru
+type Listing struct {
+	ID    string  `json:"id"`
+	Title string  `json:"title"`
+	Price float64 `json:"price"`
+	// Seller, City - продавец и город, пустые, если не указаны
+	Seller string `json:"seller"`
+	City   string `json:"city"`
+	// Condition - new, used или contract
+	Condition string `json:"condition"`
+	PhotoURL  string `json:"photo_url"`
+	URL       string `json:"url"`
+}
+
+// Page - разобранная страница списка объявлений
+type Page struct {
+	Listings []Listing `json:"listings"`
+	// NextURL - абсолютная ссылка на следующую страницу, пустая на последней
+	NextURL string `json:"next_url"`
+}
+
+// Классы разметки, по которым находятся поля объявления
+const (
+	classItem       = "bull-list-item-js"
+	classTitle      = "bull-item__self-link"
+	classPrice      = "price-block__price"
+	classSeller     = "bull-item__seller-name"
+	classCity       = "bull-delivery__city"
+	classAnnotation = "bull-item__annotation-row"
+	classImage      = "bull-image"
+)
+
+// Parse - разбор страницы списка объявлений. Относительные ссылки разрешаются относительно base.
+// Блоки без data-id (реклама, подборки) пропускаются.
+func Parse(r io.Reader, base *url.URL) (*Page, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page{Listings: []Listing{}}
+	walk(doc, func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+		if hasClass(n, classItem) {
+			if id := attr(n, "data-id"); id != "" {
+				page.Listings = append(page.Listings, parseListing(n, id, base))
+			}
+			return false
+		}
+		if n.DataAtom == atom.A && page.NextURL == "" && hasToken(attr(n, "rel"), "next") {
+			page.NextURL = resolve(base, attr(n, "href"))
+		}
+		return true
+	})
+	return page, nil
+}
+
+// parseListing - поля объявления из блока с data-id
+func parseListing(item *html.Node, id string, base *url.URL) Listing {
+	listing := Listing{ID: id}
+	walk(item, func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+		switch {
+		case hasClass(n, classTitle) && listing.URL == "":
+			listing.Title = text(n)
+			listing.URL = resolve(base, attr(n, "href"))
+			return false
+		case (hasClass(n, classPrice) || attr(n, "data-role") == "price") && listing.Price == 0:
+			listing.Price = parsePrice(text(n))
+			return false
+		case hasClass(n, classSeller) && listing.Seller == "":
+			listing.Seller = text(n)
+			return false
+		case hasClass(n, classCity) && listing.City == "":
+			listing.City = text(n)
+			return false
+		case hasClass(n, classAnnotation) && listing.Condition == "":
+			listing.Condition = parseCondition(text(n))
+			return false
+		case n.DataAtom == atom.Img && hasClass(n, classImage) && listing.PhotoURL == "":
+			// При ленивой загрузке настоящий адрес лежит в data-src, а в src - заглушка
+			src := attr(n, "data-src")
+			if src == "" {
+				src = attr(n, "src")
+			}
+			listing.PhotoURL = resolve(base, src)
+		}
+		return true
+	})
+	return listing
+}
+
+// parsePrice - цена из текста вида "1 250 ₽"; 0, если цена не указана ("Цена по запросу")
+func parsePrice(s string) float64 {
+	var digits strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	price, err := strconv.ParseFloat(digits.String(), 64)
+	if err != nil {
+		return 0
+	}
+	return price
+}
+
+// parseCondition - состояние из аннотации объявления ("Новый, оригинал", "Б/у", "Контрактный")
+func parseCondition(s string) string {
+	s = strings.ToLower(s)
+	switch {
+	case strings.Contains(s, "контракт"):
+		return models.ConditionContract
+	case strings.Contains(s, "б/у"), strings.Contains(s, "бу,"), strings.HasPrefix(s, "бу"):
+		return models.ConditionUsed
+	case strings.Contains(s, "нов"):
+		return models.ConditionNew
+	}
+	return ""
+}
+
+// walk - обход дерева в глубину; visit возвращает false, чтобы не спускаться в потомков узла
+func walk(n *html.Node, visit func(*html.Node) bool) {
+	if !visit(n) {
+		return
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, visit)
+	}
+}
+
+// text - текст узла со схлопнутыми пробелами
+func text(n *html.Node) string {
+	var b strings.Builder
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.TextNode {
+			b.WriteString(c.Data)
+			b.WriteByte(' ')
+		}
+		return true
+	})
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func hasClass(n *html.Node, class string) bool {
+	return hasToken(attr(n, "class"), class)
+}
+
+// hasToken - содержит ли список через пробел (class, rel) значение
+func hasToken(list, token string) bool {
+	for _, t := range strings.Fields(list) {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve - абсолютная ссылка относительно base, пустая строка для некорректной ссылки
+func resolve(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ""
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+	if base == nil {
+		return u.String()
+	}
+	return base.ResolveReference(u).String()
+}
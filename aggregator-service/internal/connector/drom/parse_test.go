@@ -0,0 +1,86 @@
+package drom
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// update - перезапись golden-файлов: go test ./internal/connector/drom -update
+var update = flag.Bool("update", false, "перезаписать golden-файлы в testdata")
+
+// TestParseGolden - разбор записанных страниц сравнивается с эталоном в testdata/*.golden.json
+func TestParseGolden(t *testing.T) {
+	base, err := url.Parse("https://baza.drom.ru/novosibirsk/sell_spare_parts/?query=%D1%84%D0%B8%D0%BB%D1%8C%D1%82%D1%80")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"listing_page1", "listing_page2", "listing_empty"} {
+		t.Run(name, func(t *testing.T) {
+			f, err := os.Open(filepath.Join("testdata", name+".html"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			page, err := Parse(f, base)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(page); err != nil {
+				t.Fatal(err)
+			}
+			got := buf.Bytes()
+
+			golden := filepath.Join("testdata", name+".golden.json")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("нет эталона (запустите с -update): %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("результат разбора отличается от %s:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestParsePrice(t *testing.T) {
+	for in, want := range map[string]float64{
+		"1\u00a0250 ₽":    1250,
+		"85 000 ₽":        85000,
+		"450₽":            450,
+		"Цена по запросу": 0,
+		"":                0,
+	} {
+		if got := parsePrice(in); got != want {
+			t.Errorf("parsePrice(%q) = %v, ожидалось %v", in, got, want)
+		}
+	}
+}
+
+func TestParseCondition(t *testing.T) {
+	for in, want := range map[string]string{
+		"Новый, оригинал":        "new",
+		"Б/у, с разбора":         "used",
+		"Контрактный, из Японии": "contract",
+		"Аналог":                 "",
+	} {
+		if got := parseCondition(in); got != want {
+			t.Errorf("parseCondition(%q) = %q, ожидалось %q", in, got, want)
+		}
+	}
+}
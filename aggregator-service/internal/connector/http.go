@@ -0,0 +1,56 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxResponseSize - предельный размер страницы или ответа API источника
+const maxResponseSize = 10 << 20
+
+// userAgent - заголовок User-Agent запросов к источникам
+const userAgent = "autoPick-aggregator/1.0"
+
+// StatusError - источник ответил кодом, отличным от 200
+type StatusError struct {
+	URL  string
+	Code int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: неожиданный код ответа %d", e.URL, e.Code)
+}
+
+// Fetch - GET-запрос к источнику с чтением тела ответа не больше maxResponseSize
+func Fetch(ctx context.Context, client *http.Client, url, accept string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+		return nil, &StatusError{URL: url, Code: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxResponseSize {
+		return nil, fmt.Errorf("%s: ответ больше %d байт", url, maxResponseSize)
+	}
+	return body, nil
+}
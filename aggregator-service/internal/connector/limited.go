@@ -0,0 +1,55 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+
+	"aggregator-service/internal/models"
+
+	"golang.org/x/time/rate"
+)
+
+// limited - источник с ограничением параллельности, частоты и времени запросов
+type limited struct {
+	Connector
+	slots   chan struct{}
+	limiter *rate.Limiter
+	limits  Limits
+}
+
+// WithLimits - обертка источника, соблюдающая ограничения.
+// Ожидание свободного слота и разрешения на запрос входит в тайм-аут поиска.
+func WithLimits(c Connector, limits Limits) Connector {
+	l := &limited{Connector: c, limits: limits}
+	if limits.Concurrency > 0 {
+		l.slots = make(chan struct{}, limits.Concurrency)
+	}
+	if limits.RatePerSecond > 0 {
+		l.limiter = rate.NewLimiter(rate.Limit(limits.RatePerSecond), max(limits.Burst, 1))
+	}
+	return l
+}
+
+// Search - поиск с соблюдением ограничений
+func (l *limited) Search(ctx context.Context, query string, vehicle *models.Vehicle) ([]models.Offer, error) {
+	if l.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.limits.Timeout)
+		defer cancel()
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			defer func() { <-l.slots }()
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s: ожидание свободного слота: %w", l.Name(), ctx.Err())
+		}
+	}
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("%s: ожидание разрешения на запрос: %w", l.Name(), err)
+		}
+	}
+	return l.Connector.Search(ctx, query, vehicle)
+}
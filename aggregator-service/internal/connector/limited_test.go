@@ -0,0 +1,124 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"aggregator-service/internal/models"
+)
+
+// fakeConnector - источник, считающий одновременные вызовы
+type fakeConnector struct {
+	delay   time.Duration
+	active  atomic.Int32
+	peak    atomic.Int32
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (f *fakeConnector) Name() string { return "fake" }
+
+func (f *fakeConnector) Search(ctx context.Context, _ string, _ *models.Vehicle) ([]models.Offer, error) {
+	f.calls.Add(1)
+	n := f.active.Add(1)
+	defer f.active.Add(-1)
+	for {
+		peak := f.peak.Load()
+		if n <= peak || f.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	// Источник с release не реагирует на отмену и держит слот до освобождения
+	if f.release != nil {
+		<-f.release
+		return nil, ctx.Err()
+	}
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return []models.Offer{{ExternalID: "1"}}, nil
+}
+
+func TestWithLimitsConcurrency(t *testing.T) {
+	fake := &fakeConnector{delay: 20 * time.Millisecond}
+	c := WithLimits(fake, Limits{Concurrency: 2})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Search(context.Background(), "q", nil); err != nil {
+				t.Errorf("Search: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if peak := fake.peak.Load(); peak != 2 {
+		t.Errorf("одновременных запросов %d, ожидалось 2", peak)
+	}
+	if calls := fake.calls.Load(); calls != 8 {
+		t.Errorf("вызовов %d, ожидалось 8", calls)
+	}
+}
+
+func TestWithLimitsRate(t *testing.T) {
+	fake := &fakeConnector{}
+	c := WithLimits(fake, Limits{RatePerSecond: 20, Burst: 1})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.Search(context.Background(), "q", nil); err != nil {
+			t.Fatalf("Search: %v", err)
+		}
+	}
+
+	// Первый запрос проходит сразу, два следующих ждут по 50 мс
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("три запроса заняли %v, ожидалось не меньше 100 мс", elapsed)
+	}
+}
+
+func TestWithLimitsTimeout(t *testing.T) {
+	fake := &fakeConnector{delay: time.Minute}
+	c := WithLimits(fake, Limits{Timeout: 20 * time.Millisecond})
+
+	_, err := c.Search(context.Background(), "q", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ошибка %v, ожидался DeadlineExceeded", err)
+	}
+}
+
+func TestWithLimitsTimeoutIncludesWaitForSlot(t *testing.T) {
+	fake := &fakeConnector{release: make(chan struct{})}
+	c := WithLimits(fake, Limits{Concurrency: 1, Timeout: 30 * time.Millisecond})
+
+	// Первый запрос занимает единственный слот до освобождения
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Search(context.Background(), "q", nil)
+	}()
+	for fake.active.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	_, err := c.Search(context.Background(), "q", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ошибка %v, ожидался DeadlineExceeded при ожидании слота", err)
+	}
+	if calls := fake.calls.Load(); calls != 1 {
+		t.Errorf("вызовов %d, второй запрос не должен был дойти до источника", calls)
+	}
+
+	close(fake.release)
+	<-done
+}
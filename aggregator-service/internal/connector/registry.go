@@ -0,0 +1,92 @@
+package connector
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Registry - реестр источников с признаком включения
+type Registry struct {
+	mu         sync.RWMutex
+	connectors map[string]Connector
+	enabled    map[string]bool
+}
+
+// NewRegistry - конструктор пустого реестра
+func NewRegistry() *Registry {
+	return &Registry{
+		connectors: make(map[string]Connector),
+		enabled:    make(map[string]bool),
+	}
+}
+
+// Register - регистрация источника с ограничениями; зарегистрированный источник включен.
+// Обертки применяются поверх ограничений по порядку: например, кэш, попадания в который не расходуют лимиты источника.
+func (r *Registry) Register(c Connector, limits Limits, wrappers ...func(Connector) Connector) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := c.Name()
+	if _, ok := r.connectors[name]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicate, name)
+	}
+	c = WithLimits(c, limits)
+	for _, wrap := range wrappers {
+		c = wrap(c)
+	}
+	r.connectors[name] = c
+	r.enabled[name] = true
+	return nil
+}
+
+// SetEnabled - включение или отключение источника
+func (r *Registry) SetEnabled(name string, enabled bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.connectors[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknown, name)
+	}
+	r.enabled[name] = enabled
+	return nil
+}
+
+// Get - получение источника по имени
+func (r *Registry) Get(name string) (Connector, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.connectors[name]
+	return c, ok
+}
+
+// Enabled - включенные источники в порядке имен
+func (r *Registry) Enabled() []Connector {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []Connector
+	for _, name := range r.namesLocked() {
+		if r.enabled[name] {
+			result = append(result, r.connectors[name])
+		}
+	}
+	return result
+}
+
+// Names - имена всех зарегистрированных источников по алфавиту
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.namesLocked()
+}
+
+func (r *Registry) namesLocked() []string {
+	names := make([]string, 0, len(r.connectors))
+	for name := range r.connectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,50 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"aggregator-service/internal/models"
+)
+
+type namedConnector string
+
+func (n namedConnector) Name() string { return string(n) }
+
+func (n namedConnector) Search(context.Context, string, *models.Vehicle) ([]models.Offer, error) {
+	return nil, nil
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	for _, name := range []string{"drom", "avito", "exist"} {
+		if err := r.Register(namedConnector(name), Limits{}); err != nil {
+			t.Fatalf("Register(%s): %v", name, err)
+		}
+	}
+
+	if err := r.Register(namedConnector("drom"), Limits{}); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("повторная регистрация: ошибка %v, ожидалась ErrDuplicate", err)
+	}
+	if err := r.SetEnabled("autodoc", true); !errors.Is(err, ErrUnknown) {
+		t.Errorf("неизвестный источник: ошибка %v, ожидалась ErrUnknown", err)
+	}
+	if err := r.SetEnabled("avito", false); err != nil {
+		t.Fatalf("SetEnabled: %v", err)
+	}
+
+	var enabled []string
+	for _, c := range r.Enabled() {
+		enabled = append(enabled, c.Name())
+	}
+	if len(enabled) != 2 || enabled[0] != "drom" || enabled[1] != "exist" {
+		t.Errorf("включенные источники %v, ожидалось [drom exist]", enabled)
+	}
+	if names := r.Names(); len(names) != 3 || names[0] != "avito" {
+		t.Errorf("все источники %v, ожидалось [avito drom exist]", names)
+	}
+	if _, ok := r.Get("avito"); !ok {
+		t.Error("отключенный источник должен оставаться в реестре")
+	}
+}
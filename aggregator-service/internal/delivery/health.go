@@ -0,0 +1,35 @@
+package delivery
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// HealthHandler - проверки состояния сервиса
+type HealthHandler struct {
+	shuttingDown atomic.Bool
+}
+
+// NewHealthHandler - конструктор обработчика проверок состояния
+func NewHealthHandler() *HealthHandler {
+	return &HealthHandler{}
+}
+
+// SetShuttingDown - перевод в состояние остановки, readiness начинает отвечать 503
+func (h *HealthHandler) SetShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// Healthz - liveness, отвечает 200, пока процесс запущен
+func (h *HealthHandler) Healthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// Readyz - readiness; внешние источники не опрашиваются, их недоступность не мешает обслуживать запросы
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	if h.shuttingDown.Load() {
+		writeProblem(w, r, http.StatusServiceUnavailable, "Сервис останавливается")
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
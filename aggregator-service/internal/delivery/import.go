@@ -0,0 +1,108 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"aggregator-service/internal/aggregator"
+	"aggregator-service/internal/dtos"
+	"aggregator-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// DefaultCurrency - валюта предложения, если источник ее не указал
+const DefaultCurrency = "RUB"
+
+// ImportHandler - HTTP-обработчик подготовки найденных предложений к загрузке в каталог
+type ImportHandler struct {
+	aggregator *aggregator.Aggregator
+	logger     *zap.Logger
+}
+
+// NewImportHandler - конструктор обработчика импорта
+func NewImportHandler(aggregator *aggregator.Aggregator, logger *zap.Logger) *ImportHandler {
+	return &ImportHandler{aggregator: aggregator, logger: logger}
+}
+
+// Preview - GET /products/{id}/offers/preview?q=...&make=...&model=...&year=...
+// Ищет предложения во включенных источниках и возвращает их как предложения поставщиков продукта.
+// Каталог не изменяется: менеджер загружает поле offers через PUT /offers шлюза.
+func (h *ImportHandler) Preview(w http.ResponseWriter, r *http.Request) {
+	productID := strings.TrimSpace(r.PathValue("id"))
+	query := r.URL.Query()
+
+	vehicle, err := vehicleFromQuery(query.Get("make"), query.Get("model"), query.Get("year"))
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.logger.Info("Получен запрос на подготовку импорта", zap.String("product_id", productID), zap.String("query", query.Get("q")))
+	result, err := h.aggregator.Search(r.Context(), query.Get("q"), vehicle)
+	switch {
+	case errors.Is(err, aggregator.ErrEmptyQuery):
+		writeProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	case errors.Is(err, aggregator.ErrNoSources):
+		writeProblem(w, r, http.StatusServiceUnavailable, err.Error())
+		return
+	case errors.Is(err, aggregator.ErrAllSourcesFailed):
+		h.logger.Error("Поиск не выполнен ни в одном источнике", zap.Error(err))
+		writeProblem(w, r, http.StatusBadGateway, aggregator.ErrAllSourcesFailed.Error())
+		return
+	case err != nil:
+		h.logger.Error("Ошибка поиска", zap.Error(err))
+		writeProblem(w, r, http.StatusInternalServerError, "Ошибка при поиске предложений")
+		return
+	}
+
+	resp := dtos.ImportPreviewDto{
+		Found:   len(result.Offers),
+		Offers:  make([]dtos.CatalogOfferDto, 0, len(result.Offers)),
+		Sources: toSourceDtos(result.Sources),
+	}
+	for _, o := range result.Offers {
+		// Предложения без цены ("цена по запросу") стали бы лучшим предложением продукта
+		if o.Price <= 0 || o.ExternalID == "" {
+			resp.Skipped++
+			continue
+		}
+		resp.Offers = append(resp.Offers, toCatalogOffer(productID, o))
+	}
+
+	h.logger.Info("Предложения подготовлены к импорту", zap.String("product_id", productID),
+		zap.Int("found", resp.Found), zap.Int("skipped", resp.Skipped))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// toCatalogOffer - предложение источника в виде предложения поставщика.
+// Поставщик - "<источник>:<id объявления>", поэтому повторная загрузка обновляет те же предложения.
+// Объявление означает наличие хотя бы одной единицы, поэтому неизвестное количество заменяется на 1.
+func toCatalogOffer(productID string, o models.Offer) dtos.CatalogOfferDto {
+	currency := o.Currency
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	quantity := o.Quantity
+	if quantity <= 0 {
+		quantity = 1
+	}
+	return dtos.CatalogOfferDto{
+		ProductID:    productID,
+		Supplier:     o.Source + ":" + o.ExternalID,
+		Price:        float32(o.Price),
+		Currency:     currency,
+		Quantity:     quantity,
+		DeliveryDays: o.DeliveryDays,
+		URL:          o.URL,
+		Title:        o.Title,
+		Condition:    o.Condition,
+		ImageURL:     o.ImageURL,
+		Seller:       o.Seller,
+		Location:     o.Location,
+	}
+}
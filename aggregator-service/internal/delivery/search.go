@@ -0,0 +1,125 @@
+package delivery
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"aggregator-service/internal/aggregator"
+	"aggregator-service/internal/dtos"
+	"aggregator-service/internal/models"
+
+	"go.uber.org/zap"
+)
+
+// SearchHandler - HTTP-обработчик поиска по источникам
+type SearchHandler struct {
+	aggregator *aggregator.Aggregator
+	logger     *zap.Logger
+}
+
+// NewSearchHandler - конструктор обработчика поиска
+func NewSearchHandler(aggregator *aggregator.Aggregator, logger *zap.Logger) *SearchHandler {
+	return &SearchHandler{aggregator: aggregator, logger: logger}
+}
+
+// Search - GET /search?q=...&make=...&model=...&year=...
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	vehicle, err := vehicleFromQuery(query.Get("make"), query.Get("model"), query.Get("year"))
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.logger.Info("Получен запрос на поиск", zap.String("query", query.Get("q")))
+	result, err := h.aggregator.Search(r.Context(), query.Get("q"), vehicle)
+	switch {
+	case errors.Is(err, aggregator.ErrEmptyQuery):
+		writeProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	case errors.Is(err, aggregator.ErrNoSources):
+		writeProblem(w, r, http.StatusServiceUnavailable, err.Error())
+		return
+	case errors.Is(err, aggregator.ErrAllSourcesFailed):
+		h.logger.Error("Поиск не выполнен ни в одном источнике", zap.Error(err))
+		writeProblem(w, r, http.StatusBadGateway, aggregator.ErrAllSourcesFailed.Error())
+		return
+	case err != nil:
+		h.logger.Error("Ошибка поиска", zap.Error(err))
+		writeProblem(w, r, http.StatusInternalServerError, "Ошибка при поиске предложений")
+		return
+	}
+
+	resp := dtos.SearchDto{
+		Offers:  make([]dtos.OfferDto, 0, len(result.Offers)),
+		Sources: toSourceDtos(result.Sources),
+	}
+	for _, o := range result.Offers {
+		resp.Offers = append(resp.Offers, dtos.OfferDto{
+			Source:       o.Source,
+			ExternalID:   o.ExternalID,
+			Title:        o.Title,
+			Brand:        o.Brand,
+			Article:      o.Article,
+			Price:        o.Price,
+			Currency:     o.Currency,
+			Quantity:     o.Quantity,
+			DeliveryDays: o.DeliveryDays,
+			Condition:    o.Condition,
+			Seller:       o.Seller,
+			Location:     o.Location,
+			URL:          o.URL,
+			ImageURL:     o.ImageURL,
+			FetchedAt:    o.FetchedAt,
+		})
+	}
+
+	h.logger.Info("Поиск выполнен", zap.Int("offers", len(resp.Offers)))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// toSourceDtos - итоги поиска по источникам
+func toSourceDtos(results []aggregator.SourceResult) []dtos.SourceDto {
+	sources := make([]dtos.SourceDto, 0, len(results))
+	for _, s := range results {
+		source := dtos.SourceDto{Name: s.Name, Count: s.Count, DurationMs: s.Duration.Milliseconds()}
+		if s.Err != nil {
+			source.Error = s.Err.Error()
+		}
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+// vehicleFromQuery - автомобиль из параметров запроса, nil, если марка не задана
+func vehicleFromQuery(vehicleMake, model, year string) (*models.Vehicle, error) {
+	vehicleMake = strings.TrimSpace(vehicleMake)
+	if vehicleMake == "" {
+		if model != "" || year != "" {
+			return nil, errors.New("make: марка обязательна при заданных модели или годе")
+		}
+		return nil, nil
+	}
+
+	vehicle := &models.Vehicle{Make: vehicleMake, Model: strings.TrimSpace(model)}
+	if year != "" {
+		y, err := strconv.Atoi(year)
+		if err != nil || y < 0 {
+			return nil, errors.New("year: ожидался год выпуска")
+		}
+		vehicle.Year = y
+	}
+	return vehicle, nil
+}
+
+// writeProblem - ответ в формате application/problem+json
+func writeProblem(w http.ResponseWriter, r *http.Request, code int, detail string) {
+	w.Header().Set("Content-Type", dtos.ProblemContentType)
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(dtos.NewProblem(code, detail, r.URL.Path))
+}
@@ -0,0 +1,26 @@
+package dtos
+
+import "net/http"
+
+// ProblemContentType - тип содержимого ответа с ошибкой по RFC 7807
+const ProblemContentType = "application/problem+json"
+
+// ProblemDto - описание ошибки в формате RFC 7807 (Problem Details for HTTP APIs)
+type ProblemDto struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+}
+
+// NewProblem - конструктор описания ошибки для HTTP-статуса
+func NewProblem(status int, detail, instance string) ProblemDto {
+	return ProblemDto{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: instance,
+	}
+}
@@ -0,0 +1,62 @@
+package dtos
+
+import "time"
+
+// OfferDto - предложение из внешнего источника
+type OfferDto struct {
+	Source       string    `json:"source"`
+	ExternalID   string    `json:"external_id,omitempty"`
+	Title        string    `json:"title"`
+	Brand        string    `json:"brand,omitempty"`
+	Article      string    `json:"article,omitempty"`
+	Price        float64   `json:"price"`
+	Currency     string    `json:"currency"`
+	Quantity     int       `json:"quantity,omitempty"`
+	DeliveryDays int       `json:"delivery_days,omitempty"`
+	Condition    string    `json:"condition,omitempty"`
+	Seller       string    `json:"seller,omitempty"`
+	Location     string    `json:"location,omitempty"`
+	URL          string    `json:"url,omitempty"`
+	ImageURL     string    `json:"image_url,omitempty"`
+	FetchedAt    time.Time `json:"fetched_at"`
+}
+
+// SourceDto - итог поиска в одном источнике
+type SourceDto struct {
+	Name       string `json:"name"`
+	Count      int    `json:"count"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// SearchDto - объединенный результат поиска
+type SearchDto struct {
+	Offers  []OfferDto  `json:"offers"`
+	Sources []SourceDto `json:"sources"`
+}
+
+// CatalogOfferDto - предложение поставщика в формате тела PUT /offers шлюза
+type CatalogOfferDto struct {
+	ProductID    string  `json:"product_id"`
+	Supplier     string  `json:"supplier"`
+	Price        float32 `json:"price"`
+	Currency     string  `json:"currency"`
+	Quantity     int     `json:"quantity"`
+	DeliveryDays int     `json:"delivery_days"`
+	URL          string  `json:"url,omitempty"`
+	Title        string  `json:"title,omitempty"`
+	Condition    string  `json:"condition,omitempty"`
+	ImageURL     string  `json:"image_url,omitempty"`
+	Seller       string  `json:"seller,omitempty"`
+	Location     string  `json:"location,omitempty"`
+}
+
+// ImportPreviewDto - найденные предложения, подготовленные к загрузке в каталог менеджером
+type ImportPreviewDto struct {
+	// Found - найдено предложений во всех источниках
+	Found int `json:"found"`
+	// Skipped - предложения без цены или без идентификатора, не вошедшие в offers
+	Skipped int               `json:"skipped"`
+	Offers  []CatalogOfferDto `json:"offers"`
+	Sources []SourceDto       `json:"sources"`
+}
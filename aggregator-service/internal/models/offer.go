@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// Vehicle - автомобиль, для которого ищутся запчасти
+type Vehicle struct {
+	Make  string
+	Model string
+	// Year - год выпуска, 0 - любой
+	Year int
+}
+
+// Offer - предложение из внешнего источника, приведенное к общему виду
+type Offer struct {
+	// Source - имя коннектора, вернувшего предложение
+	Source string
+	// ExternalID - идентификатор объявления или товара в источнике
+	ExternalID string
+	Title      string
+	Brand      string
+	Article    string
+	Price      float64
+	// Currency - код валюты ISO 4217
+	Currency string
+	// Quantity - доступное количество, 0 - неизвестно
+	Quantity int
+	// DeliveryDays - срок доставки в днях, 0 - неизвестен или товар в наличии
+	DeliveryDays int
+	// Condition - new, used или contract, пустое - не указано
+	Condition string
+	Seller    string
+	Location  string
+	URL       string
+	ImageURL  string
+	FetchedAt time.Time
+}
+
+// Состояние запчасти
+const (
+	ConditionNew      = "new"
+	ConditionUsed     = "used"
+	ConditionContract = "contract"
+)
@@ -1,19 +1,25 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"gateway/docs"
+	"gateway/internal/config"
 	"gateway/internal/handlers"
 	middlewares "gateway/internal/middleware"
+	"gateway/internal/models"
 	"gateway/internal/services"
+	"gateway/internal/vin"
+	"io"
 	"log"
 	"net/http"
-	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
-	"github.com/joho/godotenv"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"go.uber.org/zap"
 )
@@ -23,13 +29,17 @@ import (
 // @description gateway API
 // @host localhost:9090
 // @BasePath /
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access-токен в формате "Bearer <token>"
 func main() {
-	// Загружаем переменные окружения
-	if err := godotenv.Load(); err != nil {
-		log.Println("Не удалось загрузить .env, используем переменные окружения")
+	// Загружаем конфигурацию (.env, YAML-файл и переменные окружения)
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Ошибка загрузки конфигурации: %v", err)
 	}
-	// Подключаемся к gRPC-серверу
-	// TODO: get host:port from envs
+
 	// Создаем логгер Zap (в проде лучше использовать zap.NewProduction())
 	logger, err := zap.NewDevelopment()
 	if err != nil {
@@ -38,8 +48,7 @@ func main() {
 	defer logger.Sync()
 
 	// Создаем сервис с логированием
-	grpcAddress := "localhost:9091"
-	productService, err := services.NewProductsService(grpcAddress, logger)
+	productService, err := services.NewProductsService(cfg.Services.Products, logger)
 	if err != nil {
 		logger.Fatal("Ошибка создания gRPC клиента", zap.Error(err))
 	}
@@ -48,85 +57,179 @@ func main() {
 
 	r := chi.NewRouter()
 	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:5173", "http://127.0.0.1:5173"},
+		AllowedOrigins:   cfg.HTTP.AllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Cookie"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Cookie", handlers.CartIDHeader},
+		ExposedHeaders:   []string{"Link", "X-Total-Count", handlers.CartIDHeader},
 		AllowCredentials: true,
 		MaxAge:           500,
 	}))
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 
-	// Получаем текущий `basePath` из переменной окружения
-	basePath := os.Getenv("BASE_URL")
-	if basePath == "" {
-		basePath = "localhost:9090" // Значение по умолчанию
+	docs.SwaggerInfo.Host = cfg.HTTP.BaseURL
+
+	authService, err := services.NewAuthService(cfg.Services.Users, logger)
+	if err != nil {
+		logger.Fatal("Ошибка создания gRPC клиента", zap.Error(err))
 	}
-	docs.SwaggerInfo.Host = basePath
+	authMiddleware := middlewares.AuthMiddleware(authService)
+	// Редактирование каталога доступно менеджерам, управление пользователями - администраторам
+	managerOnly := middlewares.RequireRole(models.RoleManager, models.RoleAdmin)
+	adminOnly := middlewares.RequireRole(models.RoleAdmin)
+
 	productHandler := handlers.NewProductsHandler(*productService)
 
 	r.Route("/products", func(r chi.Router) {
 		r.With(middlewares.PaginationMiddleware).Get("/", productHandler.Get)
 		r.Get("/{id}", productHandler.GetByID)
-		// TODO: add protect middleware
-		r.Post("/", productHandler.Post)
-		// TODO: add protect middleware
-		r.Delete("/{id}", productHandler.Delete)
-		// TODO: add protect middleware
-		r.Put("/{id}", productHandler.Put)
+		r.With(authMiddleware, managerOnly).Post("/", productHandler.Post)
+		r.With(authMiddleware, managerOnly).Delete("/{id}", productHandler.Delete)
+		r.With(authMiddleware, managerOnly).Put("/{id}", productHandler.Put)
+
+		// Импорт прайс-листов поставщиков
+		r.With(authMiddleware, managerOnly).Post("/import", productHandler.Import)
+		r.With(authMiddleware, managerOnly).Get("/import/{id}", productHandler.GetImport)
+
+		r.Get("/{id}/fitments", productHandler.GetFitments)
+		r.With(authMiddleware, managerOnly).Put("/{id}/fitments", productHandler.PutFitments)
+
+		r.Get("/{id}/offers", productHandler.GetOffers)
+	})
+	r.With(authMiddleware, managerOnly).Put("/offers", productHandler.UpsertOffers)
+
+	vehicleHandler := handlers.NewVehiclesHandler(productService)
+	r.Route("/vehicles", func(r chi.Router) {
+		r.Get("/makes", vehicleHandler.Makes)
+		r.Get("/{make}/models", vehicleHandler.Models)
+	})
+
+	partHandler := handlers.NewPartsHandler(productService)
+	r.Route("/parts", func(r chi.Router) {
+		r.Get("/{article}", partHandler.FindByArticle)
+		r.With(authMiddleware, managerOnly).Post("/crossrefs", partHandler.AddCrossReferences)
 	})
 
-	// TODO: handle error
-	userService, _ := services.NewUsersService("localhost:9092", logger)
+	// Таблица кодов производителей нужна для расшифровки VIN
+	wmiTable, err := vin.LoadTableFile(cfg.VIN.WMIPath)
+	if err != nil {
+		logger.Fatal("Ошибка загрузки таблицы кодов VIN", zap.String("path", cfg.VIN.WMIPath), zap.Error(err))
+	}
+	logger.Info("Таблица кодов VIN загружена", zap.Int("codes", wmiTable.Len()))
+
+	vinHandler := handlers.NewVinHandler(vin.NewDecoder(wmiTable), productService)
+	r.With(middlewares.PaginationMiddleware).Get("/vin/{vin}", vinHandler.Decode)
+
+	userService, err := services.NewUsersService(cfg.Services.Users, logger)
+	if err != nil {
+		logger.Fatal("Ошибка создания gRPC клиента", zap.Error(err))
+	}
 	userHandler := handlers.NewUserHandler(userService)
 	r.Route("/users", func(r chi.Router) {
-		// TODO: only admin middleware
-		r.With(middlewares.PaginationMiddleware).Get("/", userHandler.GetUsers) // Получить всех пользователей
-		r.Get("/{id}", userHandler.GetUserByID)                                 // Получить пользователя по ID
-		r.Post("/", userHandler.CreateUser)                                     // Создать нового пользователя
-		r.Put("/{id}", userHandler.UpdateUser)                                  // Обновить данные пользователя
-		// TODO: only admin middleware
-		r.Delete("/{id}", userHandler.DeleteUser) // Удалить пользователя
+		r.Get("/{id}", userHandler.GetUserByID) // Получить пользователя по ID
+		r.Post("/", userHandler.CreateUser)     // Создать нового пользователя
+
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware)
+			r.Put("/{id}", userHandler.UpdateUser) // Обновить данные пользователя
+
+			r.With(adminOnly, middlewares.PaginationMiddleware).Get("/", userHandler.GetUsers) // Получить всех пользователей
+			r.With(adminOnly).Delete("/{id}", userHandler.DeleteUser)                          // Удалить пользователя
+		})
 		// TODO: Add block, confirm handlers
 	})
-	authService, _ := services.NewAuthService("localhost:9092", logger)
-	authHandler := handlers.NewAuthHandler(authService)
+	// Корзины хранит сервис заказов
+	cartService, err := services.NewCartService(cfg.Services.Orders, logger)
+	if err != nil {
+		logger.Fatal("Ошибка создания gRPC клиента", zap.Error(err))
+	}
+	authHandler := handlers.NewAuthHandler(authService, cartService)
 
 	r.Route("/auth", func(r chi.Router) {
 		r.Post("/login", authHandler.Login)
 		r.Post("/refresh", authHandler.Refresh)
 	})
 
-	orderService, _ := services.NewOrdersService("localhost:9093", logger)
+	orderService, err := services.NewOrdersService(cfg.Services.Orders, logger)
+	if err != nil {
+		logger.Fatal("Ошибка создания gRPC клиента", zap.Error(err))
+	}
 	orderHandler := handlers.NewOrdersHandler(*orderService)
 
 	r.Route("/orders", func(r chi.Router) {
-		// TODO: add pagination middleware
+		// Заказы доступны только авторизованным пользователям
+		r.Use(authMiddleware)
 		r.With(middlewares.PaginationMiddleware).Get("/", orderHandler.Get)
 
 		r.Get("/{id}", orderHandler.GetByID)
-		// TODO: add protect middleware
+		r.Get("/{id}/history", orderHandler.History)
 		r.Post("/", orderHandler.Post)
-		// TODO: add protect middleware
 		r.Delete("/{id}", orderHandler.Delete)
-		// TODO: add protect middleware
 		r.Put("/{id}", orderHandler.Put)
 	})
 
+	cartHandler := handlers.NewCartHandler(cartService)
+	r.Route("/cart", func(r chi.Router) {
+		// Корзина доступна гостям по заголовку X-Cart-ID, оформление заказа - только авторизованным
+		r.Use(middlewares.OptionalAuthMiddleware(authService))
+		r.Get("/", cartHandler.Get)
+		r.Delete("/", cartHandler.Clear)
+		r.Post("/items", cartHandler.AddItem)
+		r.Put("/items/{product_id}", cartHandler.UpdateItem)
+		r.Delete("/items/{product_id}", cartHandler.RemoveItem)
+		r.Post("/checkout", cartHandler.Checkout)
+	})
+
+	healthHandler := handlers.NewHealthHandler(map[string]handlers.HealthChecker{
+		"products": productService,
+		"users":    userService,
+		"orders":   orderService,
+	})
+	r.Get("/healthz", healthHandler.Healthz)
+	r.Get("/readyz", healthHandler.Readyz)
+
 	r.Get("/swagger/*", httpSwagger.WrapHandler)
 
 	// Запуск сервера
-	port := getEnv("CONTENT_SERVICE_PORT", "9090")
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.HTTP.Port),
+		Handler: r,
+	}
 
-	fmt.Println("Server running on port", port)
-	log.Fatal(http.ListenAndServe(":"+port, r))
-}
+	// Останавливаемся по SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		logger.Info("Сервер запущен", zap.String("addr", server.Addr))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal("Ошибка при запуске сервера", zap.Error(err))
+		}
+	}()
+
+	<-ctx.Done()
+	logger.Info("Получен сигнал завершения, останавливаем сервер", zap.Duration("timeout", cfg.ShutdownTimeout))
+	healthHandler.SetShuttingDown()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	// Дожидаемся завершения активных HTTP-запросов
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error("Не удалось дождаться завершения запросов", zap.Error(err))
+	}
 
-// Функция для получения переменной окружения с дефолтным значением
-func getEnv(key, fallback string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
+	// Закрываем gRPC соединения после того, как запросы к ним завершились
+	for name, closer := range map[string]io.Closer{
+		"products": productService,
+		"users":    userService,
+		"auth":     authService,
+		"orders":   orderService,
+		"cart":     cartService,
+	} {
+		if err := closer.Close(); err != nil {
+			logger.Error("Ошибка закрытия gRPC соединения", zap.String("service", name), zap.Error(err))
+		}
 	}
-	return fallback
+	logger.Info("Сервер остановлен")
 }
@@ -17,7 +17,7 @@ const docTemplate = `{
     "paths": {
         "/auth/login": {
             "post": {
-                "description": "Авторизует пользователя по email и password. Выдает access и refresh токены.",
+                "description": "Авторизует пользователя по email и password. Выдает access и refresh токены.\nЕсли передан X-Cart-ID, гостевая корзина переносится в корзину пользователя.",
                 "consumes": [
                     "application/json"
                 ],
@@ -29,6 +29,12 @@ const docTemplate = `{
                 ],
                 "summary": "Вход в систему",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор гостевой корзины",
+                        "name": "X-Cart-ID",
+                        "in": "header"
+                    },
                     {
                         "description": "Данные для авторизации пользователя",
                         "name": "loginCredentials",
@@ -49,13 +55,19 @@ const docTemplate = `{
                     "400": {
                         "description": "Неверные данные",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверные учетные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
                     "500": {
                         "description": "Ошибка сервера",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
@@ -95,117 +107,121 @@ const docTemplate = `{
                     "400": {
                         "description": "Неверные данные",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверные учетные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
                     "500": {
                         "description": "Ошибка сервера",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
             }
         },
-        "/orders": {
+        "/cart": {
             "get": {
-                "description": "Возвращает список заказов с возможностью пагинации",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Возвращает корзину авторизованного пользователя или гостевую корзину из заголовка X-Cart-ID.\nПозиции пересчитываются по текущим ценам каталога; позиции, которые нельзя заказать, содержат причину в problem и не входят в сумму.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cart"
                 ],
-                "summary": "Получить список заказов",
+                "summary": "Получить корзину",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "offset",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Уникальный идентификатор пользователя, который офрмлял заказы",
-                        "name": "user_id",
-                        "in": "query"
+                        "description": "Идентификатор гостевой корзины",
+                        "name": "X-Cart-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dtos.OrderDto"
-                            }
+                            "$ref": "#/definitions/dtos.CartDto"
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера",
+                    "400": {
+                        "description": "Некорректный идентификатор корзины",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Невалидный токен",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "503": {
+                        "description": "Сервис продуктов недоступен",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Обновляет существующий заказ",
-                "consumes": [
-                    "application/json"
-                ],
+            "delete": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cart"
                 ],
-                "summary": "Обновить заказ",
+                "summary": "Очистить корзину",
                 "parameters": [
                     {
-                        "description": "Обновленные данные заказа",
-                        "name": "order",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dtos.OrderDto"
-                        }
+                        "type": "string",
+                        "description": "Идентификатор гостевой корзины",
+                        "name": "X-Cart-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dtos.OrderDto"
+                            "$ref": "#/definitions/dtos.CartDto"
                         }
                     },
                     "400": {
-                        "description": "Неверные данные",
+                        "description": "Некорректный идентификатор корзины",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера",
+                    "401": {
+                        "description": "Невалидный токен",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "409": {
+                        "description": "Корзина изменилась параллельно",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/cart/checkout": {
             "post": {
-                "description": "Добавляет новый заказ",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает заказ из корзины пользователя по текущим ценам каталога и очищает корзину.\nЕсли передан expected_total и сумма заказа с ним не совпадает, заказ не создается. Гостевая корзина переносится в корзину пользователя при входе.",
                 "consumes": [
                     "application/json"
                 ],
@@ -213,17 +229,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cart"
                 ],
-                "summary": "Создать заказ",
+                "summary": "Оформить заказ из корзины",
                 "parameters": [
                     {
-                        "description": "Данные нового заказа",
-                        "name": "order",
+                        "description": "Ожидаемая сумма",
+                        "name": "checkout",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dtos.CreateOrderDto"
+                            "$ref": "#/definitions/dtos.CheckoutDto"
                         }
                     }
                 ],
@@ -235,23 +250,35 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Неверные данные",
+                        "description": "Позиции, которые нельзя заказать",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера",
+                    "401": {
+                        "description": "Требуется авторизация",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "409": {
+                        "description": "Корзина пуста, сумма изменилась, товара недостаточно или заказ уже оформляется",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "503": {
+                        "description": "Сервис продуктов недоступен",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
             }
         },
-        "/orders/{id}": {
-            "get": {
-                "description": "Возвращает информацию о заказе по его ID",
+        "/cart/items": {
+            "post": {
+                "description": "Добавляет товар в корзину, для уже добавленного товара увеличивает количество. Продукт и предложение проверяются по каталогу.\nГостю без X-Cart-ID создается новая корзина, ее идентификатор возвращается в заголовке X-Cart-ID и в поле guest_id.",
                 "consumes": [
                     "application/json"
                 ],
@@ -259,41 +286,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cart"
                 ],
-                "summary": "Получить заказ по ID",
+                "summary": "Добавить товар в корзину",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID заказа",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Идентификатор гостевой корзины",
+                        "name": "X-Cart-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Товар",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.AddCartItemDto"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dtos.OrderDto"
+                            "$ref": "#/definitions/dtos.CartDto"
+                        },
+                        "headers": {
+                            "X-Cart-ID": {
+                                "type": "string",
+                                "description": "Идентификатор гостевой корзины"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Некорректный ID",
+                        "description": "Неверные данные, неизвестный продукт или предложение",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
-                    "404": {
-                        "description": "Заказ не найден",
+                    "401": {
+                        "description": "Невалидный токен",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "409": {
+                        "description": "Корзина изменилась параллельно",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "503": {
+                        "description": "Сервис продуктов недоступен",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Удаляет заказ по ID",
+            }
+        },
+        "/cart/items/{product_id}": {
+            "put": {
                 "consumes": [
                     "application/json"
                 ],
@@ -301,63 +355,98 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "cart"
                 ],
-                "summary": "Удалить заказ",
+                "summary": "Изменить количество товара в корзине",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID заказа",
-                        "name": "id",
+                        "description": "Идентификатор гостевой корзины",
+                        "name": "X-Cart-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID продукта",
+                        "name": "product_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID предложения поставщика",
+                        "name": "offer_id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Количество",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.UpdateCartItemDto"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Заказ удален"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.CartDto"
+                        }
                     },
                     "400": {
-                        "description": "Некорректный ID",
+                        "description": "Неверные данные",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера",
+                    "401": {
+                        "description": "Невалидный токен",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Позиции нет в корзине",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "409": {
+                        "description": "Корзина изменилась параллельно",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
-            }
-        },
-        "/products": {
-            "get": {
-                "description": "Возвращает список продуктов с возможностью пагинации",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "cart"
                 ],
-                "summary": "Получить список продуктов",
+                "summary": "Удалить товар из корзины",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "offset",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Идентификатор гостевой корзины",
+                        "name": "X-Cart-ID",
+                        "in": "header"
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "ID продукта",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID предложения поставщика",
+                        "name": "offer_id",
                         "in": "query"
                     }
                 ],
@@ -365,22 +454,64 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dtos.ProductDto"
-                            }
+                            "$ref": "#/definitions/dtos.CartDto"
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера",
+                    "400": {
+                        "description": "Некорректный идентификатор корзины",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Невалидный токен",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Позиции нет в корзине",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "409": {
+                        "description": "Корзина изменилась параллельно",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "Отвечает 200, пока процесс gateway запущен",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Проверка работоспособности",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.HealthDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/offers": {
             "put": {
-                "description": "Обновляет существующий продукт",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает или обновляет предложения. Предложение определяется парой продукт-поставщик, повторная загрузка обновляет цену, наличие и срок доставки.",
                 "consumes": [
                     "application/json"
                 ],
@@ -390,15 +521,18 @@ const docTemplate = `{
                 "tags": [
                     "products"
                 ],
-                "summary": "Обновить продукт",
+                "summary": "Загрузить предложения поставщиков",
                 "parameters": [
                     {
-                        "description": "Обновленные данные продукта",
-                        "name": "product",
+                        "description": "Предложения",
+                        "name": "offers",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dtos.ProductDto"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dtos.OfferDto"
+                            }
                         }
                     }
                 ],
@@ -406,154 +540,50 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dtos.ProductDto"
+                            "$ref": "#/definitions/dtos.UpsertOffersDto"
                         }
                     },
                     "400": {
                         "description": "Неверные данные",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
                     "500": {
                         "description": "Ошибка сервера",
                         "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "description": "Добавляет новый продукт",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "products"
-                ],
-                "summary": "Создать продукт",
-                "parameters": [
-                    {
-                        "description": "Данные нового продукта",
-                        "name": "product",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dtos.CreateProductDto"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/dtos.ProductDto"
-                        }
-                    },
-                    "400": {
-                        "description": "Неверные данные",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Ошибка сервера",
-                        "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
             }
         },
-        "/products/{id}": {
+        "/orders": {
             "get": {
-                "description": "Возвращает информацию о продукте по его ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "products"
-                ],
-                "summary": "Получить продукт по ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "ID продукта",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dtos.ProductDto"
-                        }
-                    },
-                    "400": {
-                        "description": "Некорректный ID",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Продукт не найден",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Удаляет продукт по ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "products"
-                ],
-                "summary": "Удалить продукт",
-                "parameters": [
+                "security": [
                     {
-                        "type": "string",
-                        "description": "ID продукта",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "BearerAuth": []
                     }
                 ],
-                "responses": {
-                    "204": {
-                        "description": "Продукт удален"
-                    },
-                    "400": {
-                        "description": "Некорректный ID",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Ошибка сервера",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            }
-        },
-        "/users": {
-            "get": {
-                "description": "Получение списка пользователей с возможностью пагинации по страницам и лимиту.",
+                "description": "Возвращает список заказов с возможностью пагинации. Покупатель видит только свои заказы, фильтр по user_id доступен сотрудникам.",
                 "consumes": [
                     "application/json"
                 ],
@@ -561,9 +591,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "orders"
                 ],
-                "summary": "Получить список пользователей с пагинацией",
+                "summary": "Получить список заказов",
                 "parameters": [
                     {
                         "type": "integer",
@@ -578,6 +608,18 @@ const docTemplate = `{
                         "description": "Items per page",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из заголовка Link",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Уникальный идентификатор пользователя, который офрмлял заказы",
+                        "name": "user_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -586,26 +628,43 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dtos.UserDto"
+                                "$ref": "#/definitions/dtos.OrderDto"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Ссылка на следующую страницу (rel=next)"
                             }
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Некорректный курсор",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Ошибка сервера",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Создание нового пользователя с предоставленными данными. Статус почты - неподтвержденный, роль - пользователь.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет новый заказ от имени текущего пользователя. Сотрудник может указать user_id другого пользователя.\nЦена за единицу, суммы позиций и итог рассчитываются по текущему каталогу: позиция с offer_id - по предложению поставщика, без него - по цене продукта.\nОстатки предложений резервируются под заказ до reserved_until; резерв списывается при оплате и снимается при отмене или по истечении срока.\nПосле резерва и авторизации платежа заказ переходит в confirmed. При временной недоступности склада или платежей заказ возвращается в pending и оформляется в фоне.",
                 "consumes": [
                     "application/json"
                 ],
@@ -613,17 +672,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "orders"
                 ],
-                "summary": "Создать нового пользователя",
+                "summary": "Создать заказ",
                 "parameters": [
                     {
-                        "description": "User Data",
-                        "name": "user",
+                        "description": "Данные нового заказа",
+                        "name": "order",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dtos.CreateUserDto"
+                            "$ref": "#/definitions/dtos.CreateOrderDto"
                         }
                     }
                 ],
@@ -631,27 +690,50 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dtos.UserDto"
+                            "$ref": "#/definitions/dtos.OrderDto"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Неверные данные, неизвестный продукт или предложение",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "409": {
+                        "description": "Недостаточно товара в наличии или платеж отклонен",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Ошибка сервера",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "503": {
+                        "description": "Сервис продуктов недоступен",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
             }
         },
-        "/users/{id}": {
+        "/orders/{id}": {
             "get": {
-                "description": "Получение данных пользователя по ID",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает информацию о заказе по его ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -659,13 +741,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "orders"
                 ],
-                "summary": "Получить пользователя по ID",
+                "summary": "Получить заказ по ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "ID заказа",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -675,25 +757,36 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dtos.UserDto"
+                            "$ref": "#/definitions/dtos.OrderDto"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Некорректный ID",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
                     "404": {
-                        "description": "Not found",
+                        "description": "Заказ не найден",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Обновление данных пользователя по ID",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переводит заказ в новый статус: pending -\u003e confirmed -\u003e paid -\u003e shipped -\u003e delivered, отмена (cancelled) до оплаты, возврат (refunded) после оплаты.\nПокупатель может только отменить свой заказ, остальные переходы выполняют сотрудники. Переход сохраняется в истории статусов.",
                 "consumes": [
                     "application/json"
                 ],
@@ -701,24 +794,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "orders"
                 ],
-                "summary": "Обновить данные пользователя",
+                "summary": "Изменить статус заказа",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "ID заказа",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated User Data",
-                        "name": "user",
+                        "description": "Новый статус",
+                        "name": "order",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dtos.CreateUserDto"
+                            "$ref": "#/definitions/dtos.UpdateOrderStatusDto"
                         }
                     }
                 ],
@@ -726,31 +819,54 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dtos.UserDto"
+                            "$ref": "#/definitions/dtos.OrderDto"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Неверные данные",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Переход доступен только сотрудникам",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
                     "404": {
-                        "description": "Not found",
+                        "description": "Заказ не найден",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "409": {
+                        "description": "Недопустимый переход, заказ еще оформляется, резерв заказа истек или статус изменился параллельно",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Ошибка сервера",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Удаление пользователя по ID",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаляет заказ по ID. Покупатель может удалить свой заказ только в статусе pending или cancelled, сотрудник - любой заказ. Резерв неоплаченного заказа возвращается в остатки, авторизация платежа снимается.",
                 "consumes": [
                     "application/json"
                 ],
@@ -758,13 +874,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "orders"
                 ],
-                "summary": "Удалить пользователя по ID",
+                "summary": "Удалить заказ",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "ID заказа",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -772,81 +888,1744 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "204": {
-                        "description": "User deleted successfully",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "Заказ удален"
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Некорректный ID",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dtos.ProblemDto"
                         }
                     },
-                    "404": {
-                        "description": "Not found",
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Заказ в этом статусе может удалить только сотрудник",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "503": {
+                        "description": "Сервис продуктов или платежей недоступен",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает изменения статуса заказа в хронологическом порядке, начиная с создания, с автором и временем",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "История статусов заказа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID заказа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dtos.StatusChangeDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/parts/crossrefs": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет пары взаимозаменяемых артикулов. Кросс действует в обе стороны, уже существующие пары пропускаются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parts"
+                ],
+                "summary": "Добавить кроссы",
+                "parameters": [
+                    {
+                        "description": "Кроссы",
+                        "name": "crossrefs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dtos.CrossReferenceDto"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.AddCrossReferencesDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/parts/{article}": {
+            "get": {
+                "description": "Возвращает продукты с артикулом (номер OEM или производителя) и их аналоги по таблице кроссов.\nПробелы, дефисы и регистр в артикуле не учитываются: 04152-YZZA1 и 04152yzza1 - один артикул.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parts"
+                ],
+                "summary": "Найти запчасть по артикулу",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Артикул",
+                        "name": "article",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Производитель",
+                        "name": "brand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.PartDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный артикул",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Ни продукт, ни аналоги не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "description": "Возвращает страницу продуктов. При заданных q, category, min_price, max_price, sort, vehicle или attr.\u003cназвание\u003e выполняется поиск.\nПараметр vehicle задается в формате марка:модель[:год[:код двигателя[:кузов]]], например vehicle=Toyota:Camry:2012.\nОбщее количество передается в заголовке X-Total-Count, ссылки на соседние страницы - в заголовке Link.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Получить или найти продукты",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Полнотекстовый запрос по названию и описанию",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Категория",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Минимальная цена",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Максимальная цена",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "relevance",
+                            "price_asc",
+                            "price_desc",
+                            "newest"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Автомобиль, которому должны подходить продукты",
+                        "name": "vehicle",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dtos.ProductDto"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Ссылки на страницы first, prev, next, last"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Общее количество продуктов"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры поиска или пагинации",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Обновляет существующий продукт",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Обновить продукт",
+                "parameters": [
+                    {
+                        "description": "Обновленные данные продукта",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProductDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProductDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет новый продукт",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Создать продукт",
+                "parameters": [
+                    {
+                        "description": "Данные нового продукта",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.CreateProductDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProductDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Принимает прайс-лист CSV или XLSX и запускает импорт в фоне. Продукт ищется по артикулу и производителю и создается, если его нет; цена, остаток и срок доставки сохраняются как предложение поставщика, поэтому повторный импорт обновляет те же предложения.\nХод импорта и ошибки по строкам - в GET /products/import/{id}.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Импорт прайс-листа поставщика",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Прайс-лист",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Поставщик",
+                        "name": "supplier",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Сопоставление столбцов, JSON dtos.ColumnMappingDto: название столбца из заголовка, номер с 1 или буква. Обязательны article и price",
+                        "name": "mapping",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "Формат, по умолчанию по расширению файла",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Номер строки заголовка, 0 - заголовка нет",
+                        "name": "header_row",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Лист XLSX, по умолчанию первый",
+                        "name": "sheet",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Разделитель CSV, по умолчанию определяется по первой строке",
+                        "name": "delimiter",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "default": "RUB",
+                        "description": "Валюта цен",
+                        "name": "currency",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ImportJobDto"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "Адрес задачи импорта"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "413": {
+                        "description": "Файл слишком большой",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/import/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает состояние задачи импорта, счетчики строк и первые 1000 ошибок по строкам.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Состояние импорта прайс-листа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID задачи импорта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ImportJobDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Задача не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "description": "Возвращает информацию о продукте по его ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Получить продукт по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID продукта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProductDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаляет продукт по ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Удалить продукт",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID продукта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Продукт удален"
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/fitments": {
+            "get": {
+                "description": "Возвращает список автомобилей, к которым подходит продукт",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Получить применимость продукта",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID продукта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dtos.FitmentDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Полностью заменяет список автомобилей, к которым подходит продукт. Пустой список удаляет применимость.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Заменить применимость продукта",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID продукта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новая применимость",
+                        "name": "fitments",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dtos.FitmentDto"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dtos.FitmentDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется авторизация",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Недостаточно прав",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/offers": {
+            "get": {
+                "description": "Возвращает продукт и предложения поставщиков. Предложения в наличии идут первыми, затем по цене или сроку доставки.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Получить продукт со всеми предложениями",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID продукта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "price",
+                            "delivery"
+                        ],
+                        "type": "string",
+                        "default": "price",
+                        "description": "Сортировка предложений",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProductWithOffersDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Продукт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Проверяет состояние gRPC-сервисов и возвращает статус каждой зависимости",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Проверка готовности",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.HealthDto"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.HealthDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получение списка пользователей с возможностью пагинации по страницам и лимиту.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Получить список пользователей с пагинацией",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из заголовка Link",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dtos.UserDto"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Ссылка на следующую страницу (rel=next)"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создание нового пользователя с предоставленными данными. Статус почты - неподтвержденный, роль - пользователь.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Создать нового пользователя",
+                "parameters": [
+                    {
+                        "description": "User Data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.CreateUserDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.UserDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "409": {
+                        "description": "User already exists",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "description": "Получение данных пользователя по ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Получить пользователя по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.UserDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Обновление имени и пароля пользователя по ID. Пустые поля не меняются; email, роль и блокировка этим запросом не меняются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Обновить данные пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated User Data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.CreateUserDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.UserDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаление пользователя по ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Удалить пользователя по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "User deleted successfully",
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles/makes": {
+            "get": {
+                "description": "Возвращает марки автомобилей, для которых в каталоге есть запчасти",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Получить список марок",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles/{make}/models": {
+            "get": {
+                "description": "Возвращает модели марки, для которых в каталоге есть запчасти. Регистр названия марки не учитывается.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Получить список моделей марки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Марка",
+                        "name": "make",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Не передана марка",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        },
+        "/vin/{vin}": {
+            "get": {
+                "description": "Определяет производителя, модель и модельный год по VIN. Для североамериканских VIN проверяется контрольная цифра.\nС products=true возвращает подходящие продукты, если удалось определить марку и модель; пагинация продуктов передается в заголовках X-Total-Count и Link.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Расшифровать VIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "VIN",
+                        "name": "vin",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Подобрать подходящие продукты",
+                        "name": "products",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.VinDto"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Ссылки на страницы first, prev, next, last"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Общее количество подходящих продуктов"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный VIN",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ProblemDto"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dtos.AddCartItemDto": {
+            "type": "object",
+            "properties": {
+                "offer_id": {
+                    "description": "OfferID - предложение поставщика, без него используется цена из каталога",
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dtos.AddCrossReferencesDto": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "description": "Количество новых записей, уже существующие пропускаются",
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.AuthCredentialsDto": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.CartDto": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "guest_id": {
+                    "description": "GuestID - идентификатор гостевой корзины, передается в заголовке X-Cart-ID",
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.CartItemDto"
+                    }
+                },
+                "ready": {
+                    "description": "Ready - корзина не пуста и все позиции можно заказать",
+                    "type": "boolean"
+                },
+                "subtotal": {
+                    "description": "Subtotal - сумма позиций, которые можно заказать",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.CartItemDto": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string"
+                },
+                "added_unit_price": {
+                    "type": "number"
+                },
+                "line_total": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "offer_id": {
+                    "type": "string"
+                },
+                "problem": {
+                    "description": "Problem - причина, по которой позицию нельзя заказать",
+                    "type": "string",
+                    "example": "в наличии 1 шт."
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "supplier": {
+                    "type": "string"
+                },
+                "unit_price": {
+                    "description": "UnitPrice - текущая цена, AddedUnitPrice - цена на момент добавления в корзину",
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.CheckoutDto": {
+            "type": "object",
+            "properties": {
+                "expected_total": {
+                    "description": "ExpectedTotal - сумма, которую видел покупатель; если цены изменились, заказ не создается",
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.CreateOrderDto": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.CreateOrderItemDto"
                     }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.CreateOrderItemDto": {
+            "type": "object",
+            "properties": {
+                "offer_id": {
+                    "description": "OfferID - предложение поставщика, без него используется цена из каталога",
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "string",
+                    "example": "b3f1c2d4-0000-4000-8000-000000000001"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dtos.CreateProductDto": {
+            "type": "object",
+            "properties": {
+                "article": {
+                    "type": "string"
+                },
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "brand": {
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.CreateUserDto": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.CrossReferenceDto": {
+            "type": "object",
+            "properties": {
+                "analog_article": {
+                    "type": "string"
+                },
+                "analog_brand": {
+                    "type": "string"
+                },
+                "article": {
+                    "type": "string"
+                },
+                "brand": {
+                    "description": "Пустой производитель означает любого производителя с этим артикулом",
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.DependencyHealthDto": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.FitmentDto": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "engine_code": {
+                    "description": "Пустые значения означают любой двигатель и кузов",
+                    "type": "string"
+                },
+                "generation": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "make": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "year_from": {
+                    "description": "Границы годов выпуска включительно, 0 - без ограничения",
+                    "type": "integer"
+                },
+                "year_to": {
+                    "type": "integer"
                 }
             }
-        }
-    },
-    "definitions": {
-        "dtos.AuthCredentialsDto": {
+        },
+        "dtos.HealthDto": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
+                "dependencies": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/dtos.DependencyHealthDto"
+                    }
                 },
-                "refresh_token": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "dtos.CreateOrderDto": {
+        "dtos.ImportJobDto": {
             "type": "object",
             "properties": {
-                "id": {
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "description": "Ошибка, остановившая импорт целиком",
                     "type": "string"
                 },
-                "products_ids": {
+                "errors": {
+                    "description": "Первые 1000 ошибок строк",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dtos.ImportRowErrorDto"
                     }
                 },
-                "total": {
+                "errors_truncated": {
+                    "type": "boolean"
+                },
+                "failed": {
                     "type": "integer"
                 },
-                "user_id": {
+                "finished_at": {
+                    "type": "string"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "offers_inserted": {
+                    "type": "integer"
+                },
+                "offers_updated": {
+                    "type": "integer"
+                },
+                "products_created": {
+                    "type": "integer"
+                },
+                "rows": {
+                    "description": "Строки данных: обработанные, загруженные и с ошибками",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Состояние: pending, running, done или failed",
+                    "type": "string"
+                },
+                "supplier": {
                     "type": "string"
                 }
             }
         },
-        "dtos.CreateProductDto": {
+        "dtos.ImportRowErrorDto": {
             "type": "object",
             "properties": {
-                "attributes": {
-                    "type": "object",
-                    "additionalProperties": {}
-                },
-                "category": {
+                "column": {
                     "type": "string"
                 },
-                "description": {
+                "message": {
                     "type": "string"
                 },
+                "row": {
+                    "description": "Номер строки в файле, начиная с 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.InvalidParamDto": {
+            "type": "object",
+            "properties": {
                 "name": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "reason": {
+                    "type": "string"
                 }
             }
         },
-        "dtos.CreateUserDto": {
+        "dtos.LoginDto": {
             "type": "object",
             "properties": {
                 "email": {
@@ -854,19 +2633,60 @@ const docTemplate = `{
                 },
                 "password": {
                     "type": "string"
-                },
-                "username": {
-                    "type": "string"
                 }
             }
         },
-        "dtos.LoginDto": {
+        "dtos.OfferDto": {
             "type": "object",
             "properties": {
-                "email": {
+                "condition": {
+                    "description": "Состояние: new, used или contract",
                     "type": "string"
                 },
-                "password": {
+                "currency": {
+                    "description": "Код валюты ISO 4217, по умолчанию RUB",
+                    "type": "string"
+                },
+                "delivery_days": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "Остаток поставщика, 0 - нет в наличии",
+                    "type": "integer"
+                },
+                "reserved": {
+                    "description": "Зарезервировано под заказы, доступно quantity - reserved",
+                    "type": "integer"
+                },
+                "seller": {
+                    "type": "string"
+                },
+                "supplier": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Данные объявления для предложений с досок объявлений",
                     "type": "string"
                 }
             }
@@ -877,33 +2697,141 @@ const docTemplate = `{
                 "created_at": {
                     "type": "string"
                 },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
                 "id": {
                     "type": "string"
                 },
-                "products_ids": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dtos.OrderItemDto"
                     }
                 },
+                "reserved_until": {
+                    "description": "Срок резерва товаров: неоплаченный к этому времени заказ нельзя оплатить",
+                    "type": "string"
+                },
                 "status": {
                     "type": "string"
                 },
+                "subtotal": {
+                    "type": "number"
+                },
                 "total": {
-                    "type": "integer"
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
                 },
                 "user_id": {
                     "type": "string"
                 }
             }
         },
+        "dtos.OrderItemDto": {
+            "type": "object",
+            "properties": {
+                "line_total": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "offer_id": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "supplier": {
+                    "type": "string"
+                },
+                "unit_price": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.PartDto": {
+            "type": "object",
+            "properties": {
+                "analogs": {
+                    "description": "Аналоги по таблице кроссов",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.ProductDto"
+                    }
+                },
+                "article_normalized": {
+                    "description": "Артикул из запроса без разделителей в верхнем регистре",
+                    "type": "string"
+                },
+                "exact": {
+                    "description": "Продукты с искомым артикулом",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.ProductDto"
+                    }
+                }
+            }
+        },
+        "dtos.ProblemDto": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string"
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "invalid_params": {
+                    "description": "InvalidParams - нарушения по полям запроса для ошибок валидации",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.InvalidParamDto"
+                    }
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
         "dtos.ProductDto": {
             "type": "object",
             "properties": {
+                "article": {
+                    "type": "string"
+                },
+                "article_normalized": {
+                    "description": "Артикул без разделителей в верхнем регистре, выставляется сервисом",
+                    "type": "string"
+                },
                 "attributes": {
                     "type": "object",
                     "additionalProperties": {}
                 },
+                "best_offer": {
+                    "description": "Самое дешевое предложение в наличии и количество предложений в наличии",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dtos.OfferDto"
+                        }
+                    ]
+                },
+                "brand": {
+                    "type": "string"
+                },
                 "category": {
                     "type": "string"
                 },
@@ -919,14 +2847,95 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
-                "price": {
+                "offer_count": {
                     "type": "integer"
                 },
+                "price": {
+                    "type": "number"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
+        "dtos.ProductWithOffersDto": {
+            "type": "object",
+            "properties": {
+                "offers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.OfferDto"
+                    }
+                },
+                "product": {
+                    "$ref": "#/definitions/dtos.ProductDto"
+                }
+            }
+        },
+        "dtos.StatusChangeDto": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_role": {
+                    "type": "string"
+                },
+                "changed_at": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.UpdateCartItemDto": {
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dtos.UpdateOrderStatusDto": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Comment - комментарий к смене статуса, сохраняется в истории",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "confirmed",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled",
+                        "refunded"
+                    ],
+                    "example": "confirmed"
+                }
+            }
+        },
+        "dtos.UpsertOffersDto": {
+            "type": "object",
+            "properties": {
+                "inserted": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
         "dtos.UserDto": {
             "type": "object",
             "properties": {
@@ -952,6 +2961,58 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "dtos.VinDto": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string"
+                },
+                "make": {
+                    "description": "Пустые, если кода нет в таблице производителей",
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "north_american": {
+                    "type": "boolean"
+                },
+                "products": {
+                    "description": "Подходящие продукты, только при products=true и определенных марке и модели",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.ProductDto"
+                    }
+                },
+                "region": {
+                    "type": "string"
+                },
+                "vds": {
+                    "type": "string"
+                },
+                "vin": {
+                    "type": "string"
+                },
+                "vis": {
+                    "type": "string"
+                },
+                "wmi": {
+                    "description": "Код производителя, описательная и указательная части",
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Access-токен в формате \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
@@ -1,14 +1,198 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
 )
 
+// Config - конфигурация gateway
+type Config struct {
+	HTTP     HTTPConfig     `yaml:"http"`
+	Services ServicesConfig `yaml:"services"`
+	VIN      VINConfig      `yaml:"vin"`
+	// ShutdownTimeout - время на завершение активных запросов при остановке
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// HTTPConfig - параметры HTTP-сервера
+type HTTPConfig struct {
+	Port           int      `yaml:"port"`
+	BaseURL        string   `yaml:"base_url"`
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+// ServicesConfig - адреса gRPC-сервисов
+type ServicesConfig struct {
+	Products string `yaml:"products"`
+	Users    string `yaml:"users"`
+	Orders   string `yaml:"orders"`
+}
+
+// VINConfig - параметры декодера VIN
+type VINConfig struct {
+	// WMIPath - путь к таблице кодов производителей
+	WMIPath string `yaml:"wmi_path"`
+}
+
+// LoadEnv - загрузка переменных окружения из .env, если файл существует
 func LoadEnv() {
 	err := godotenv.Load()
 	if err != nil {
 		log.Println("No .env file found")
 	}
 }
+
+// Load - загрузка конфигурации.
+// Порядок применения: значения по умолчанию, YAML-файл из CONFIG_PATH (если задан), переменные окружения.
+func Load() (*Config, error) {
+	LoadEnv()
+
+	cfg := &Config{
+		HTTP: HTTPConfig{
+			Port:           9090,
+			BaseURL:        "localhost:9090",
+			AllowedOrigins: []string{"http://localhost:5173", "http://127.0.0.1:5173"},
+		},
+		Services: ServicesConfig{
+			Products: "localhost:9091",
+			Users:    "localhost:9092",
+			Orders:   "localhost:9093",
+		},
+		VIN: VINConfig{
+			WMIPath: "data/wmi.csv",
+		},
+		ShutdownTimeout: 15 * time.Second,
+	}
+
+	if path := os.Getenv("CONFIG_PATH"); path != "" {
+		if err := loadYAML(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("невалидная конфигурация: %w", err)
+	}
+	return cfg, nil
+}
+
+// Validate - проверка корректности конфигурации
+func (c *Config) Validate() error {
+	var errs []error
+	if c.HTTP.Port <= 0 || c.HTTP.Port > 65535 {
+		errs = append(errs, fmt.Errorf("http.port (CONTENT_SERVICE_PORT): порт %d вне диапазона 1-65535", c.HTTP.Port))
+	}
+	if c.HTTP.BaseURL == "" {
+		errs = append(errs, errors.New("http.base_url (BASE_URL): значение не задано"))
+	}
+	errs = append(errs,
+		validateAddr("services.products (PRODUCTS_SERVICE_ADDR)", c.Services.Products),
+		validateAddr("services.users (USERS_SERVICE_ADDR)", c.Services.Users),
+		validateAddr("services.orders (ORDERS_SERVICE_ADDR)", c.Services.Orders),
+	)
+	if c.VIN.WMIPath == "" {
+		errs = append(errs, errors.New("vin.wmi_path (VIN_WMI_PATH): путь не задан"))
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown_timeout (SHUTDOWN_TIMEOUT): время должно быть положительным"))
+	}
+	return errors.Join(errs...)
+}
+
+// loadEnv - переопределение параметров переменными окружения
+func (c *Config) loadEnv() error {
+	envString(&c.HTTP.BaseURL, "BASE_URL")
+	envList(&c.HTTP.AllowedOrigins, "CORS_ALLOWED_ORIGINS")
+	envString(&c.Services.Products, "PRODUCTS_SERVICE_ADDR")
+	envString(&c.Services.Users, "USERS_SERVICE_ADDR")
+	envString(&c.Services.Orders, "ORDERS_SERVICE_ADDR")
+	envString(&c.VIN.WMIPath, "VIN_WMI_PATH")
+	return errors.Join(
+		envInt(&c.HTTP.Port, "CONTENT_SERVICE_PORT"),
+		envDuration(&c.ShutdownTimeout, "SHUTDOWN_TIMEOUT"),
+	)
+}
+
+func loadYAML(path string, cfg *Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("не удалось открыть файл конфигурации: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("не удалось разобрать файл конфигурации %s: %w", path, err)
+	}
+	return nil
+}
+
+func validateAddr(name, addr string) error {
+	if addr == "" {
+		return fmt.Errorf("%s: адрес не задан", name)
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return fmt.Errorf("%s: некорректный адрес %q: %w", name, addr, err)
+	}
+	return nil
+}
+
+func envString(dst *string, key string) {
+	if value, ok := os.LookupEnv(key); ok {
+		*dst = value
+	}
+}
+
+func envInt(dst *int, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалось целое число, получено %q", key, value)
+	}
+	*dst = n
+	return nil
+}
+
+func envDuration(dst *time.Duration, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалась длительность (например, 15s), получено %q", key, value)
+	}
+	*dst = d
+	return nil
+}
+
+func envList(dst *[]string, key string) {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*dst = items
+}
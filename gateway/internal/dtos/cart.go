@@ -0,0 +1,51 @@
+package dtos
+
+import "time"
+
+// CartDto - корзина с позициями по текущим ценам каталога
+type CartDto struct {
+	// GuestID - идентификатор гостевой корзины, передается в заголовке X-Cart-ID
+	GuestID string        `json:"guest_id,omitempty"`
+	Items   []CartItemDto `json:"items"`
+	// Subtotal - сумма позиций, которые можно заказать
+	Subtotal float64 `json:"subtotal"`
+	Currency string  `json:"currency,omitempty" example:"RUB"`
+	// Ready - корзина не пуста и все позиции можно заказать
+	Ready     bool       `json:"ready"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// CartItemDto - позиция корзины
+type CartItemDto struct {
+	ProductID string `json:"product_id"`
+	OfferID   string `json:"offer_id,omitempty"`
+	Quantity  int    `json:"quantity"`
+	Name      string `json:"name"`
+	Supplier  string `json:"supplier,omitempty"`
+	// UnitPrice - текущая цена, AddedUnitPrice - цена на момент добавления в корзину
+	UnitPrice      float64 `json:"unit_price"`
+	AddedUnitPrice float64 `json:"added_unit_price"`
+	LineTotal      float64 `json:"line_total"`
+	// Problem - причина, по которой позицию нельзя заказать
+	Problem string    `json:"problem,omitempty" example:"в наличии 1 шт."`
+	AddedAt time.Time `json:"added_at"`
+}
+
+// AddCartItemDto - товар, добавляемый в корзину
+type AddCartItemDto struct {
+	ProductID string `json:"product_id"`
+	// OfferID - предложение поставщика, без него используется цена из каталога
+	OfferID  string `json:"offer_id,omitempty"`
+	Quantity int    `json:"quantity" example:"1"`
+}
+
+// UpdateCartItemDto - новое количество позиции корзины
+type UpdateCartItemDto struct {
+	Quantity int `json:"quantity" example:"2"`
+}
+
+// CheckoutDto - оформление заказа из корзины
+type CheckoutDto struct {
+	// ExpectedTotal - сумма, которую видел покупатель; если цены изменились, заказ не создается
+	ExpectedTotal float64 `json:"expected_total,omitempty"`
+}
@@ -0,0 +1,13 @@
+package dtos
+
+// HealthDto - состояние gateway и его зависимостей
+type HealthDto struct {
+	Status       string                         `json:"status"`
+	Dependencies map[string]DependencyHealthDto `json:"dependencies,omitempty"`
+}
+
+// DependencyHealthDto - состояние отдельной зависимости
+type DependencyHealthDto struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
@@ -0,0 +1,46 @@
+package dtos
+
+import "time"
+
+// ColumnMappingDto - столбцы прайс-листа: название из заголовка, номер столбца с 1 или буква (A, B, ...)
+type ColumnMappingDto struct {
+	Article      string `json:"article"`
+	Brand        string `json:"brand,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Price        string `json:"price"`
+	Quantity     string `json:"quantity,omitempty"`
+	DeliveryDays string `json:"delivery_days,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Category     string `json:"category,omitempty"`
+}
+
+// ImportRowErrorDto - ошибка в строке прайс-листа
+type ImportRowErrorDto struct {
+	// Номер строки в файле, начиная с 1
+	Row     int    `json:"row"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportJobDto - задача импорта прайс-листа с отчетом по строкам
+type ImportJobDto struct {
+	ID       string `json:"id"`
+	Supplier string `json:"supplier"`
+	Format   string `json:"format"`
+	// Состояние: pending, running, done или failed
+	Status string `json:"status"`
+	// Строки данных: обработанные, загруженные и с ошибками
+	Rows            int64 `json:"rows"`
+	Imported        int64 `json:"imported"`
+	Failed          int64 `json:"failed"`
+	ProductsCreated int64 `json:"products_created"`
+	OffersInserted  int64 `json:"offers_inserted"`
+	OffersUpdated   int64 `json:"offers_updated"`
+	// Первые 1000 ошибок строк
+	Errors          []ImportRowErrorDto `json:"errors"`
+	ErrorsTruncated bool                `json:"errors_truncated"`
+	// Ошибка, остановившая импорт целиком
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
@@ -0,0 +1,39 @@
+package dtos
+
+import "time"
+
+// OfferDto - предложение поставщика
+type OfferDto struct {
+	ID        string  `json:"id,omitempty"`
+	ProductID string  `json:"product_id"`
+	Supplier  string  `json:"supplier"`
+	Price     float32 `json:"price"`
+	// Код валюты ISO 4217, по умолчанию RUB
+	Currency string `json:"currency,omitempty"`
+	// Остаток поставщика, 0 - нет в наличии
+	Quantity int `json:"quantity"`
+	// Зарезервировано под заказы, доступно quantity - reserved
+	Reserved     int       `json:"reserved,omitempty"`
+	DeliveryDays int       `json:"delivery_days"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	// Данные объявления для предложений с досок объявлений
+	URL   string `json:"url,omitempty"`
+	Title string `json:"title,omitempty"`
+	// Состояние: new, used или contract
+	Condition string `json:"condition,omitempty"`
+	ImageURL  string `json:"image_url,omitempty"`
+	Seller    string `json:"seller,omitempty"`
+	Location  string `json:"location,omitempty"`
+}
+
+// ProductWithOffersDto - продукт со всеми предложениями поставщиков
+type ProductWithOffersDto struct {
+	Product ProductDto `json:"product"`
+	Offers  []OfferDto `json:"offers"`
+}
+
+// UpsertOffersDto - результат загрузки предложений
+type UpsertOffersDto struct {
+	Inserted int64 `json:"inserted"`
+	Updated  int64 `json:"updated"`
+}
@@ -2,24 +2,59 @@ package dtos
 
 import "time"
 
+// CreateOrderDto - новый заказ. Цены и сумма рассчитываются сервисом заказов по каталогу.
 type CreateOrderDto struct {
-	ID          string   `json:"id"`
-	UserID      string   `json:"user_id"`
-	ProductsIDs []string `json:"products_ids"`
-	Total       uint     `json:"total"`
+	UserID string               `json:"user_id"`
+	Items  []CreateOrderItemDto `json:"items"`
+}
+
+// CreateOrderItemDto - позиция нового заказа
+type CreateOrderItemDto struct {
+	ProductID string `json:"product_id" example:"b3f1c2d4-0000-4000-8000-000000000001"`
+	// OfferID - предложение поставщика, без него используется цена из каталога
+	OfferID  string `json:"offer_id,omitempty"`
+	Quantity int    `json:"quantity" example:"2"`
 }
 
 type OrderDto struct {
-	ID          string   `json:"id"`
-	UserID      string   `json:"user_id"`
-	Status      string   `json:"status"`
-	ProductsIDs []string `json:"products_ids"`
-	Total       uint     `json:"total"`
+	ID       string         `json:"id"`
+	UserID   string         `json:"user_id"`
+	Status   string         `json:"status"`
+	Items    []OrderItemDto `json:"items"`
+	Subtotal float64        `json:"subtotal"`
+	Total    float64        `json:"total"`
+	Currency string         `json:"currency" example:"RUB"`
 
 	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// Срок резерва товаров: неоплаченный к этому времени заказ нельзя оплатить
+	ReservedUntil *time.Time `json:"reserved_until,omitempty"`
+}
+
+// OrderItemDto - позиция заказа с ценой на момент оформления
+type OrderItemDto struct {
+	ProductID string  `json:"product_id"`
+	OfferID   string  `json:"offer_id,omitempty"`
+	Supplier  string  `json:"supplier,omitempty"`
+	Name      string  `json:"name"`
+	Quantity  int     `json:"quantity"`
+	UnitPrice float64 `json:"unit_price"`
+	LineTotal float64 `json:"line_total"`
 }
 
+// UpdateOrderStatusDto - смена статуса заказа
 type UpdateOrderStatusDto struct {
-	ID     string `json:"id"`
-	Status string `json:"status"`
+	Status string `json:"status" enums:"confirmed,paid,shipped,delivered,cancelled,refunded" example:"confirmed"`
+	// Comment - комментарий к смене статуса, сохраняется в истории
+	Comment string `json:"comment,omitempty"`
+}
+
+// StatusChangeDto - запись истории статусов заказа
+type StatusChangeDto struct {
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to"`
+	ActorID   string    `json:"actor_id"`
+	ActorRole string    `json:"actor_role,omitempty"`
+	Comment   string    `json:"comment,omitempty"`
+	ChangedAt time.Time `json:"changed_at"`
 }
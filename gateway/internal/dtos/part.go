@@ -0,0 +1,26 @@
+package dtos
+
+// PartDto - результат поиска по артикулу
+type PartDto struct {
+	// Артикул из запроса без разделителей в верхнем регистре
+	ArticleNormalized string `json:"article_normalized"`
+	// Продукты с искомым артикулом
+	Exact []ProductDto `json:"exact"`
+	// Аналоги по таблице кроссов
+	Analogs []ProductDto `json:"analogs"`
+}
+
+// CrossReferenceDto - взаимозаменяемость двух артикулов, действует в обе стороны
+type CrossReferenceDto struct {
+	// Пустой производитель означает любого производителя с этим артикулом
+	Brand         string `json:"brand"`
+	Article       string `json:"article"`
+	AnalogBrand   string `json:"analog_brand"`
+	AnalogArticle string `json:"analog_article"`
+}
+
+// AddCrossReferencesDto - результат добавления кроссов
+type AddCrossReferencesDto struct {
+	// Количество новых записей, уже существующие пропускаются
+	Added int64 `json:"added"`
+}
@@ -0,0 +1,34 @@
+package dtos
+
+import "net/http"
+
+// ProblemContentType - тип содержимого ответа с ошибкой по RFC 7807
+const ProblemContentType = "application/problem+json"
+
+// ProblemDto - описание ошибки в формате RFC 7807 (Problem Details for HTTP APIs)
+type ProblemDto struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	// InvalidParams - нарушения по полям запроса для ошибок валидации
+	InvalidParams []InvalidParamDto `json:"invalid_params,omitempty"`
+}
+
+// InvalidParamDto - нарушение ограничения на поле запроса
+type InvalidParamDto struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// NewProblem - конструктор описания ошибки для HTTP-статуса
+func NewProblem(status int, detail, instance string) ProblemDto {
+	return ProblemDto{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: instance,
+	}
+}
@@ -9,6 +9,8 @@ type CreateProductDto struct {
 	Price       float32        `json:"price"`
 	Category    string         `json:"category"`
 	Attributes  map[string]any `json:"attributes"`
+	Brand       string         `json:"brand,omitempty"`
+	Article     string         `json:"article,omitempty"`
 }
 
 // DTO для получения продукта
@@ -16,9 +18,16 @@ type ProductDto struct {
 	ID          string         `json:"id"`
 	Name        string         `json:"name"`
 	Description string         `json:"description"`
-	Price       uint           `json:"price"`
+	Price       float32        `json:"price"`
 	Category    string         `json:"category"`
 	Attributes  map[string]any `json:"attributes"`
+	Brand       string         `json:"brand,omitempty"`
+	Article     string         `json:"article,omitempty"`
+	// Артикул без разделителей в верхнем регистре, выставляется сервисом
+	ArticleNormalized string `json:"article_normalized,omitempty"`
+	// Самое дешевое предложение в наличии и количество предложений в наличии
+	BestOffer  *OfferDto `json:"best_offer,omitempty"`
+	OfferCount int       `json:"offer_count"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
@@ -0,0 +1,15 @@
+package dtos
+
+// FitmentDto - применимость продукта к автомобилям одной модели
+type FitmentDto struct {
+	ID         string `json:"id,omitempty"`
+	Make       string `json:"make"`
+	Model      string `json:"model"`
+	Generation string `json:"generation,omitempty"`
+	// Границы годов выпуска включительно, 0 - без ограничения
+	YearFrom int `json:"year_from,omitempty"`
+	YearTo   int `json:"year_to,omitempty"`
+	// Пустые значения означают любой двигатель и кузов
+	EngineCode string `json:"engine_code,omitempty"`
+	Body       string `json:"body,omitempty"`
+}
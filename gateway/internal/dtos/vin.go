@@ -0,0 +1,19 @@
+package dtos
+
+// VinDto - результат разбора VIN
+type VinDto struct {
+	VIN string `json:"vin"`
+	// Код производителя, описательная и указательная части
+	WMI           string `json:"wmi"`
+	VDS           string `json:"vds"`
+	VIS           string `json:"vis"`
+	Region        string `json:"region,omitempty"`
+	NorthAmerican bool   `json:"north_american"`
+	// Пустые, если кода нет в таблице производителей
+	Make    string `json:"make,omitempty"`
+	Country string `json:"country,omitempty"`
+	Model   string `json:"model,omitempty"`
+	Year    int    `json:"year,omitempty"`
+	// Подходящие продукты, только при products=true и определенных марке и модели
+	Products []ProductDto `json:"products,omitempty"`
+}
@@ -10,29 +10,34 @@ import (
 
 type AuthHandler struct {
 	service *services.AuthService
+	carts   *services.CartService
 }
 
-func NewAuthHandler(service *services.AuthService) *AuthHandler {
+func NewAuthHandler(service *services.AuthService, carts *services.CartService) *AuthHandler {
 	return &AuthHandler{
 		service: service,
+		carts:   carts,
 	}
 }
 
 // Reftesh godoc
 // @Summary Вход в систему
 // @Description Авторизует пользователя по email и password. Выдает access и refresh токены.
+// @Description Если передан X-Cart-ID, гостевая корзина переносится в корзину пользователя.
 // @Tags auth
 // @Accept  json
 // @Produce  json
+// @Param X-Cart-ID header string false "Идентификатор гостевой корзины"
 // @Param loginCredentials body dtos.LoginDto true "Данные для авторизации пользователя"
 // @Success 201 {object} dtos.AuthCredentialsDto
-// @Failure 400 {string} string "Неверные данные"
-// @Failure 500 {string} string "Ошибка сервера"
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные"
+// @Failure 401 {object} dtos.ProblemDto "Неверные учетные данные"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	var loginDto dtos.LoginDto
 	if err := json.NewDecoder(r.Body).Decode(&loginDto); err != nil {
-		http.Error(w, "Ошибка при разборе JSON", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
 		return
 	}
 
@@ -41,10 +46,14 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		Password: loginDto.Password,
 	})
 	if err != nil {
-		http.Error(w, "Ошибка при работе сервиса", http.StatusInternalServerError)
+		writeError(w, r, err, "Ошибка при работе сервиса")
 		return
 	}
 
+	if guestID := r.Header.Get(CartIDHeader); guestID != "" {
+		h.mergeGuestCart(r, authCredentials.AccessToken, guestID)
+	}
+
 	authDto := dtos.AuthCredentialsDto{
 		AccessToken:  authCredentials.AccessToken,
 		RefreshToken: authCredentials.RefreshToken,
@@ -54,6 +63,16 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(authDto)
 }
 
+// mergeGuestCart - перенос гостевой корзины в корзину вошедшего пользователя.
+// Ошибка переноса не мешает входу: ее записывает в лог сервис корзин, гостевая корзина остается доступной по X-Cart-ID.
+func (h *AuthHandler) mergeGuestCart(r *http.Request, accessToken, guestID string) {
+	claims, err := h.service.Validate(r.Context(), accessToken)
+	if err != nil {
+		return
+	}
+	_, _ = h.carts.Merge(r.Context(), claims, guestID)
+}
+
 // Reftesh godoc
 // @Summary Обновить сессионный токен
 // @Description Обновляет сессионный access токен, инвалидирует старый refresh токен, создает новый.
@@ -62,13 +81,14 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param authCredentials body dtos.AuthCredentialsDto true "Данные сессионных токенов"
 // @Success 201 {object} dtos.AuthCredentialsDto
-// @Failure 400 {string} string "Неверные данные"
-// @Failure 500 {string} string "Ошибка сервера"
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные"
+// @Failure 401 {object} dtos.ProblemDto "Неверные учетные данные"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
 // @Router /auth/refresh [post]
 func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 	var tokens dtos.AuthCredentialsDto
 	if err := json.NewDecoder(r.Body).Decode(&tokens); err != nil {
-		http.Error(w, "Ошибка при разборе JSON", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
 		return
 	}
 	authCredentials, err := h.service.Refresh(r.Context(), &models.AuthCredentials{
@@ -76,7 +96,7 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 		RefreshToken: tokens.RefreshToken,
 	})
 	if err != nil {
-		http.Error(w, "Ошибка при работе сервиса", http.StatusInternalServerError)
+		writeError(w, r, err, "Ошибка при работе сервиса")
 		return
 	}
 
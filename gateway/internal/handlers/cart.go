@@ -0,0 +1,272 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"gateway/internal/dtos"
+	"gateway/internal/middleware"
+	"gateway/internal/models"
+	"gateway/internal/services"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// CartIDHeader - заголовок с идентификатором гостевой корзины
+const CartIDHeader = "X-Cart-ID"
+
+// CartHandler - обработчик корзины
+type CartHandler struct {
+	service *services.CartService
+}
+
+// NewCartHandler - конструктор обработчика корзины
+func NewCartHandler(service *services.CartService) *CartHandler {
+	return &CartHandler{service: service}
+}
+
+// GetCart godoc
+// @Summary Получить корзину
+// @Description Возвращает корзину авторизованного пользователя или гостевую корзину из заголовка X-Cart-ID.
+// @Description Позиции пересчитываются по текущим ценам каталога; позиции, которые нельзя заказать, содержат причину в problem и не входят в сумму.
+// @Tags cart
+// @Produce  json
+// @Param X-Cart-ID header string false "Идентификатор гостевой корзины"
+// @Success 200 {object} dtos.CartDto
+// @Failure 400 {object} dtos.ProblemDto "Некорректный идентификатор корзины"
+// @Failure 401 {object} dtos.ProblemDto "Невалидный токен"
+// @Failure 503 {object} dtos.ProblemDto "Сервис продуктов недоступен"
+// @Router /cart [get]
+func (h *CartHandler) Get(w http.ResponseWriter, r *http.Request) {
+	owner := cartOwner(r)
+	if owner.Claims == nil && owner.GuestID == "" {
+		writeCart(w, http.StatusOK, models.Cart{})
+		return
+	}
+
+	cart, err := h.service.Get(r.Context(), owner)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при получении корзины")
+		return
+	}
+	writeCart(w, http.StatusOK, cart)
+}
+
+// AddCartItem godoc
+// @Summary Добавить товар в корзину
+// @Description Добавляет товар в корзину, для уже добавленного товара увеличивает количество. Продукт и предложение проверяются по каталогу.
+// @Description Гостю без X-Cart-ID создается новая корзина, ее идентификатор возвращается в заголовке X-Cart-ID и в поле guest_id.
+// @Tags cart
+// @Accept  json
+// @Produce  json
+// @Param X-Cart-ID header string false "Идентификатор гостевой корзины"
+// @Param item body dtos.AddCartItemDto true "Товар"
+// @Success 200 {object} dtos.CartDto
+// @Header 200 {string} X-Cart-ID "Идентификатор гостевой корзины"
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные, неизвестный продукт или предложение"
+// @Failure 401 {object} dtos.ProblemDto "Невалидный токен"
+// @Failure 409 {object} dtos.ProblemDto "Корзина изменилась параллельно"
+// @Failure 503 {object} dtos.ProblemDto "Сервис продуктов недоступен"
+// @Router /cart/items [post]
+func (h *CartHandler) AddItem(w http.ResponseWriter, r *http.Request) {
+	var dto dtos.AddCartItemDto
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
+		return
+	}
+
+	owner := cartOwner(r)
+	if owner.Claims == nil && owner.GuestID == "" {
+		guestID, err := newGuestID()
+		if err != nil {
+			writeProblem(w, r, http.StatusInternalServerError, "Не удалось создать корзину")
+			return
+		}
+		owner.GuestID = guestID
+	}
+
+	cart, err := h.service.Add(r.Context(), owner, models.CartItem{
+		ProductID: dto.ProductID,
+		OfferID:   dto.OfferID,
+		Quantity:  dto.Quantity,
+	})
+	if err != nil {
+		writeError(w, r, err, "Ошибка при добавлении товара в корзину")
+		return
+	}
+	writeCart(w, http.StatusOK, cart)
+}
+
+// UpdateCartItem godoc
+// @Summary Изменить количество товара в корзине
+// @Tags cart
+// @Accept  json
+// @Produce  json
+// @Param X-Cart-ID header string false "Идентификатор гостевой корзины"
+// @Param product_id path string true "ID продукта"
+// @Param offer_id query string false "ID предложения поставщика"
+// @Param item body dtos.UpdateCartItemDto true "Количество"
+// @Success 200 {object} dtos.CartDto
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные"
+// @Failure 401 {object} dtos.ProblemDto "Невалидный токен"
+// @Failure 404 {object} dtos.ProblemDto "Позиции нет в корзине"
+// @Failure 409 {object} dtos.ProblemDto "Корзина изменилась параллельно"
+// @Router /cart/items/{product_id} [put]
+func (h *CartHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	var dto dtos.UpdateCartItemDto
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
+		return
+	}
+
+	cart, err := h.service.Update(r.Context(), cartOwner(r), models.CartItem{
+		ProductID: chi.URLParam(r, "product_id"),
+		OfferID:   r.URL.Query().Get("offer_id"),
+		Quantity:  dto.Quantity,
+	})
+	if err != nil {
+		writeError(w, r, err, "Ошибка при изменении позиции корзины")
+		return
+	}
+	writeCart(w, http.StatusOK, cart)
+}
+
+// RemoveCartItem godoc
+// @Summary Удалить товар из корзины
+// @Tags cart
+// @Produce  json
+// @Param X-Cart-ID header string false "Идентификатор гостевой корзины"
+// @Param product_id path string true "ID продукта"
+// @Param offer_id query string false "ID предложения поставщика"
+// @Success 200 {object} dtos.CartDto
+// @Failure 400 {object} dtos.ProblemDto "Некорректный идентификатор корзины"
+// @Failure 401 {object} dtos.ProblemDto "Невалидный токен"
+// @Failure 404 {object} dtos.ProblemDto "Позиции нет в корзине"
+// @Failure 409 {object} dtos.ProblemDto "Корзина изменилась параллельно"
+// @Router /cart/items/{product_id} [delete]
+func (h *CartHandler) RemoveItem(w http.ResponseWriter, r *http.Request) {
+	cart, err := h.service.Remove(r.Context(), cartOwner(r), chi.URLParam(r, "product_id"), r.URL.Query().Get("offer_id"))
+	if err != nil {
+		writeError(w, r, err, "Ошибка при удалении позиции корзины")
+		return
+	}
+	writeCart(w, http.StatusOK, cart)
+}
+
+// ClearCart godoc
+// @Summary Очистить корзину
+// @Tags cart
+// @Produce  json
+// @Param X-Cart-ID header string false "Идентификатор гостевой корзины"
+// @Success 200 {object} dtos.CartDto
+// @Failure 400 {object} dtos.ProblemDto "Некорректный идентификатор корзины"
+// @Failure 401 {object} dtos.ProblemDto "Невалидный токен"
+// @Failure 409 {object} dtos.ProblemDto "Корзина изменилась параллельно"
+// @Router /cart [delete]
+func (h *CartHandler) Clear(w http.ResponseWriter, r *http.Request) {
+	cart, err := h.service.Clear(r.Context(), cartOwner(r))
+	if err != nil {
+		writeError(w, r, err, "Ошибка при очистке корзины")
+		return
+	}
+	writeCart(w, http.StatusOK, cart)
+}
+
+// Checkout godoc
+// @Summary Оформить заказ из корзины
+// @Description Создает заказ из корзины пользователя по текущим ценам каталога и очищает корзину.
+// @Description Если передан expected_total и сумма заказа с ним не совпадает, заказ не создается. Гостевая корзина переносится в корзину пользователя при входе.
+// @Tags cart
+// @Accept  json
+// @Produce  json
+// @Param checkout body dtos.CheckoutDto false "Ожидаемая сумма"
+// @Success 201 {object} dtos.OrderDto
+// @Failure 400 {object} dtos.ProblemDto "Позиции, которые нельзя заказать"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 409 {object} dtos.ProblemDto "Корзина пуста, сумма изменилась, товара недостаточно или заказ уже оформляется"
+// @Failure 503 {object} dtos.ProblemDto "Сервис продуктов недоступен"
+// @Security BearerAuth
+// @Router /cart/checkout [post]
+func (h *CartHandler) Checkout(w http.ResponseWriter, r *http.Request) {
+	claims, ok := middleware.GetClaimsFromCtx(r.Context())
+	if !ok {
+		writeProblem(w, r, http.StatusUnauthorized, "Требуется авторизация")
+		return
+	}
+
+	var dto dtos.CheckoutDto
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
+			return
+		}
+	}
+
+	order, err := h.service.Checkout(r.Context(), claims, dto.ExpectedTotal)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при оформлении заказа")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(toOrderDto(order))
+}
+
+// cartOwner - владелец корзины: авторизованный пользователь или гость из заголовка X-Cart-ID
+func cartOwner(r *http.Request) models.CartOwner {
+	if claims, ok := middleware.GetClaimsFromCtx(r.Context()); ok {
+		return models.CartOwner{Claims: claims}
+	}
+	return models.CartOwner{GuestID: r.Header.Get(CartIDHeader)}
+}
+
+// writeCart - ответ с корзиной, для гостевой корзины ее идентификатор дублируется в заголовке X-Cart-ID
+func writeCart(w http.ResponseWriter, code int, cart models.Cart) {
+	if cart.GuestID != "" {
+		w.Header().Set(CartIDHeader, cart.GuestID)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(toCartDto(cart))
+}
+
+func toCartDto(cart models.Cart) dtos.CartDto {
+	dto := dtos.CartDto{
+		GuestID:  cart.GuestID,
+		Items:    make([]dtos.CartItemDto, 0, len(cart.Items)),
+		Subtotal: cart.Subtotal,
+		Currency: cart.Currency,
+		Ready:    cart.Ready,
+	}
+	if !cart.UpdatedAt.IsZero() {
+		dto.UpdatedAt = &cart.UpdatedAt
+	}
+	for _, item := range cart.Items {
+		dto.Items = append(dto.Items, dtos.CartItemDto{
+			ProductID:      item.ProductID,
+			OfferID:        item.OfferID,
+			Quantity:       item.Quantity,
+			Name:           item.Name,
+			Supplier:       item.Supplier,
+			UnitPrice:      item.UnitPrice,
+			AddedUnitPrice: item.AddedUnitPrice,
+			LineTotal:      item.LineTotal,
+			Problem:        item.Problem,
+			AddedAt:        item.AddedAt,
+		})
+	}
+	return dto
+}
+
+// newGuestID - случайный UUID версии 4 для новой гостевой корзины
+func newGuestID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"gateway/internal/dtos"
+	"gateway/internal/services"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcToHTTP - соответствие кодов gRPC кодам HTTP
+var grpcToHTTP = map[codes.Code]int{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.FailedPrecondition: http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+}
+
+// httpStatusFromError - HTTP-статус, соответствующий ошибке сервиса
+func httpStatusFromError(err error) int {
+	if errors.Is(err, services.ErrInvalidToken) {
+		return http.StatusUnauthorized
+	}
+	if code, ok := grpcToHTTP[status.Code(err)]; ok {
+		return code
+	}
+	return http.StatusInternalServerError
+}
+
+// writeError - ответ клиенту на ошибку сервиса.
+// Для клиентских ошибок (4xx) в detail передается сообщение сервиса, для серверных - fallback,
+// чтобы не раскрывать внутренние подробности.
+func writeError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	code := httpStatusFromError(err)
+
+	problem := dtos.NewProblem(code, fallback, r.URL.Path)
+	if st, ok := status.FromError(err); ok && code < http.StatusInternalServerError {
+		if st.Message() != "" {
+			problem.Detail = st.Message()
+		}
+		problem.InvalidParams = invalidParams(st)
+	}
+
+	encodeProblem(w, problem)
+}
+
+// invalidParams - нарушения по полям из деталей gRPC-статуса (errdetails.BadRequest)
+func invalidParams(st *status.Status) []dtos.InvalidParamDto {
+	var params []dtos.InvalidParamDto
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range badRequest.GetFieldViolations() {
+			params = append(params, dtos.InvalidParamDto{Name: v.GetField(), Reason: v.GetDescription()})
+		}
+	}
+	return params
+}
+
+// writeProblem - ответ в формате application/problem+json
+func writeProblem(w http.ResponseWriter, r *http.Request, code int, detail string) {
+	encodeProblem(w, dtos.NewProblem(code, detail, r.URL.Path))
+}
+
+func encodeProblem(w http.ResponseWriter, problem dtos.ProblemDto) {
+	w.Header().Set("Content-Type", dtos.ProblemContentType)
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(problem)
+}
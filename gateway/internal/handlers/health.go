@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"gateway/internal/dtos"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	healthStatusOK          = "ok"
+	healthStatusUnavailable = "unavailable"
+
+	// healthCheckTimeout - время ожидания ответа от зависимости
+	healthCheckTimeout = 2 * time.Second
+)
+
+// HealthChecker - зависимость, состояние которой можно проверить
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
+
+// HealthHandler - обработчик проверок состояния gateway
+type HealthHandler struct {
+	dependencies map[string]HealthChecker
+	shuttingDown atomic.Bool
+}
+
+// NewHealthHandler - конструктор обработчика проверок состояния
+func NewHealthHandler(dependencies map[string]HealthChecker) *HealthHandler {
+	return &HealthHandler{dependencies: dependencies}
+}
+
+// SetShuttingDown - перевод gateway в состояние остановки, после чего /readyz отвечает 503
+func (h *HealthHandler) SetShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// Healthz godoc
+// @Summary Проверка работоспособности
+// @Description Отвечает 200, пока процесс gateway запущен
+// @Tags health
+// @Produce  json
+// @Success 200 {object} dtos.HealthDto
+// @Router /healthz [get]
+func (h *HealthHandler) Healthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(dtos.HealthDto{Status: healthStatusOK})
+}
+
+// Readyz godoc
+// @Summary Проверка готовности
+// @Description Проверяет состояние gRPC-сервисов и возвращает статус каждой зависимости
+// @Tags health
+// @Produce  json
+// @Success 200 {object} dtos.HealthDto
+// @Failure 503 {object} dtos.HealthDto
+// @Router /readyz [get]
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+	defer cancel()
+
+	resp := dtos.HealthDto{
+		Status:       healthStatusOK,
+		Dependencies: make(map[string]dtos.DependencyHealthDto, len(h.dependencies)),
+	}
+
+	// Опрашиваем зависимости параллельно
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, checker := range h.dependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			dep := dtos.DependencyHealthDto{Status: healthStatusOK}
+			if err := checker.CheckHealth(ctx); err != nil {
+				dep = dtos.DependencyHealthDto{Status: healthStatusUnavailable, Error: err.Error()}
+			}
+
+			mu.Lock()
+			resp.Dependencies[name] = dep
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	code := http.StatusOK
+	for _, dep := range resp.Dependencies {
+		if dep.Status != healthStatusOK {
+			resp.Status = healthStatusUnavailable
+			code = http.StatusServiceUnavailable
+		}
+	}
+	if h.shuttingDown.Load() {
+		resp.Status = healthStatusUnavailable
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"gateway/internal/dtos"
+	"gateway/internal/models"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// maxImportUploadSize - предельный размер запроса импорта, сервис продуктов принимает файлы до 50 МБ
+const maxImportUploadSize = 50<<20 + 1<<20
+
+// Import godoc
+// @Summary Импорт прайс-листа поставщика
+// @Description Принимает прайс-лист CSV или XLSX и запускает импорт в фоне. Продукт ищется по артикулу и производителю и создается, если его нет; цена, остаток и срок доставки сохраняются как предложение поставщика, поэтому повторный импорт обновляет те же предложения.
+// @Description Ход импорта и ошибки по строкам - в GET /products/import/{id}.
+// @Tags products
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param file formData file true "Прайс-лист"
+// @Param supplier formData string true "Поставщик"
+// @Param mapping formData string true "Сопоставление столбцов, JSON dtos.ColumnMappingDto: название столбца из заголовка, номер с 1 или буква. Обязательны article и price"
+// @Param format formData string false "Формат, по умолчанию по расширению файла" Enums(csv, xlsx)
+// @Param header_row formData int false "Номер строки заголовка, 0 - заголовка нет" default(1)
+// @Param sheet formData string false "Лист XLSX, по умолчанию первый"
+// @Param delimiter formData string false "Разделитель CSV, по умолчанию определяется по первой строке"
+// @Param currency formData string false "Валюта цен" default(RUB)
+// @Success 202 {object} dtos.ImportJobDto
+// @Header 202 {string} Location "Адрес задачи импорта"
+// @Failure 400 {object} dtos.ProblemDto "Неверные параметры"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Недостаточно прав"
+// @Failure 413 {object} dtos.ProblemDto "Файл слишком большой"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
+// @Router /products/import [post]
+func (p *ProductsHandler) Import(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportUploadSize)
+	if err := r.ParseMultipartForm(8 << 20); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeProblem(w, r, http.StatusRequestEntityTooLarge, "Файл больше 50 МБ")
+			return
+		}
+		writeProblem(w, r, http.StatusBadRequest, "Ожидалась форма multipart/form-data")
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "Не передан файл")
+		return
+	}
+	defer file.Close()
+
+	spec := models.ImportSpec{
+		Supplier:  r.FormValue("supplier"),
+		Format:    strings.ToLower(strings.TrimSpace(r.FormValue("format"))),
+		HeaderRow: 1,
+		Sheet:     r.FormValue("sheet"),
+		Delimiter: r.FormValue("delimiter"),
+		Currency:  r.FormValue("currency"),
+	}
+	if spec.Format == "" {
+		spec.Format = strings.TrimPrefix(strings.ToLower(filepath.Ext(header.Filename)), ".")
+	}
+	if value := r.FormValue("header_row"); value != "" {
+		if spec.HeaderRow, err = strconv.Atoi(value); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, "header_row: ожидался номер строки")
+			return
+		}
+	}
+
+	var mapping dtos.ColumnMappingDto
+	if err := json.Unmarshal([]byte(r.FormValue("mapping")), &mapping); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "mapping: ожидался JSON с сопоставлением столбцов")
+		return
+	}
+	spec.Mapping = models.ColumnMapping{
+		Article:      mapping.Article,
+		Brand:        mapping.Brand,
+		Name:         mapping.Name,
+		Price:        mapping.Price,
+		Quantity:     mapping.Quantity,
+		DeliveryDays: mapping.DeliveryDays,
+		Description:  mapping.Description,
+		Category:     mapping.Category,
+	}
+
+	job, err := p.service.ImportProducts(r.Context(), spec, file)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при импорте прайс-листа")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/products/import/"+job.ID)
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(toImportJobDto(job))
+}
+
+// GetImport godoc
+// @Summary Состояние импорта прайс-листа
+// @Description Возвращает состояние задачи импорта, счетчики строк и первые 1000 ошибок по строкам.
+// @Tags products
+// @Produce  json
+// @Param id path string true "ID задачи импорта"
+// @Success 200 {object} dtos.ImportJobDto
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Недостаточно прав"
+// @Failure 404 {object} dtos.ProblemDto "Задача не найдена"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
+// @Router /products/import/{id} [get]
+func (p *ProductsHandler) GetImport(w http.ResponseWriter, r *http.Request) {
+	job, err := p.service.GetImportJob(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, r, err, "Ошибка при получении задачи импорта")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(toImportJobDto(job))
+}
+
+func toImportJobDto(job *models.ImportJob) dtos.ImportJobDto {
+	dto := dtos.ImportJobDto{
+		ID:              job.ID,
+		Supplier:        job.Supplier,
+		Format:          job.Format,
+		Status:          job.Status,
+		Rows:            job.Rows,
+		Imported:        job.Imported,
+		Failed:          job.Failed,
+		ProductsCreated: job.ProductsCreated,
+		OffersInserted:  job.OffersInserted,
+		OffersUpdated:   job.OffersUpdated,
+		Errors:          make([]dtos.ImportRowErrorDto, 0, len(job.Errors)),
+		ErrorsTruncated: job.ErrorsTruncated,
+		Error:           job.Error,
+		CreatedAt:       job.CreatedAt,
+		FinishedAt:      job.FinishedAt,
+	}
+	for _, e := range job.Errors {
+		dto.Errors = append(dto.Errors, dtos.ImportRowErrorDto{Row: e.Row, Column: e.Column, Message: e.Message})
+	}
+	return dto
+}
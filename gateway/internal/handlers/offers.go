@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"gateway/internal/dtos"
+	"gateway/internal/models"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// GetOffers godoc
+// @Summary Получить продукт со всеми предложениями
+// @Description Возвращает продукт и предложения поставщиков. Предложения в наличии идут первыми, затем по цене или сроку доставки.
+// @Tags products
+// @Produce  json
+// @Param id path string true "ID продукта"
+// @Param sort query string false "Сортировка предложений" Enums(price, delivery) default(price)
+// @Success 200 {object} dtos.ProductWithOffersDto
+// @Failure 400 {object} dtos.ProblemDto "Некорректные параметры"
+// @Failure 404 {object} dtos.ProblemDto "Продукт не найден"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Router /products/{id}/offers [get]
+func (p *ProductsHandler) GetOffers(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeProblem(w, r, http.StatusBadRequest, "Не передан id продукта")
+		return
+	}
+
+	result, err := p.service.GetWithOffers(r.Context(), id, r.URL.Query().Get("sort"))
+	if err != nil {
+		writeError(w, r, err, "Ошибка при получении предложений по продукту")
+		return
+	}
+
+	resp := dtos.ProductWithOffersDto{
+		Product: toProductDto(result.Product),
+		Offers:  make([]dtos.OfferDto, 0, len(result.Offers)),
+	}
+	for i := range result.Offers {
+		resp.Offers = append(resp.Offers, *toOfferDto(&result.Offers[i]))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// UpsertOffers godoc
+// @Summary Загрузить предложения поставщиков
+// @Description Создает или обновляет предложения. Предложение определяется парой продукт-поставщик, повторная загрузка обновляет цену, наличие и срок доставки.
+// @Tags products
+// @Accept  json
+// @Produce  json
+// @Param offers body []dtos.OfferDto true "Предложения"
+// @Success 200 {object} dtos.UpsertOffersDto
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Недостаточно прав"
+// @Failure 404 {object} dtos.ProblemDto "Продукт не найден"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
+// @Router /offers [put]
+func (p *ProductsHandler) UpsertOffers(w http.ResponseWriter, r *http.Request) {
+	var dto []dtos.OfferDto
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
+		return
+	}
+
+	offers := make([]models.Offer, 0, len(dto))
+	for _, offer := range dto {
+		offers = append(offers, models.Offer{
+			ProductID:    offer.ProductID,
+			Supplier:     offer.Supplier,
+			Price:        offer.Price,
+			Currency:     offer.Currency,
+			Quantity:     offer.Quantity,
+			DeliveryDays: offer.DeliveryDays,
+			URL:          offer.URL,
+			Title:        offer.Title,
+			Condition:    offer.Condition,
+			ImageURL:     offer.ImageURL,
+			Seller:       offer.Seller,
+			Location:     offer.Location,
+		})
+	}
+
+	inserted, updated, err := p.service.UpsertOffers(r.Context(), offers)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при загрузке предложений")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(dtos.UpsertOffersDto{Inserted: inserted, Updated: updated})
+}
+
+// toOfferDto - преобразование предложения в DTO ответа, nil для пустого значения
+func toOfferDto(offer *models.Offer) *dtos.OfferDto {
+	if offer == nil {
+		return nil
+	}
+	return &dtos.OfferDto{
+		ID:           offer.ID,
+		ProductID:    offer.ProductID,
+		Supplier:     offer.Supplier,
+		Price:        offer.Price,
+		Currency:     offer.Currency,
+		Quantity:     offer.Quantity,
+		Reserved:     offer.Reserved,
+		DeliveryDays: offer.DeliveryDays,
+		UpdatedAt:    offer.UpdatedAt,
+		URL:          offer.URL,
+		Title:        offer.Title,
+		Condition:    offer.Condition,
+		ImageURL:     offer.ImageURL,
+		Seller:       offer.Seller,
+		Location:     offer.Location,
+	}
+}
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"fmt"
 	"gateway/internal/dtos"
 	"gateway/internal/middleware"
 	"gateway/internal/models"
@@ -26,15 +25,20 @@ func NewOrdersHandler(service services.OrdersService) *OrdersHandler {
 
 // GetOrders godoc
 // @Summary Получить список заказов
-// @Description Возвращает список заказов с возможностью пагинации
+// @Description Возвращает список заказов с возможностью пагинации. Покупатель видит только свои заказы, фильтр по user_id доступен сотрудникам.
 // @Tags orders
 // @Accept  json
 // @Produce  json
 // @Param offset query int false "offset" default(0)
 // @Param limit query int false "Items per page" default(10)
+// @Param cursor query string false "Курсор следующей страницы из заголовка Link"
 // @Param user_id query string false "Уникальный идентификатор пользователя, который офрмлял заказы"
 // @Success 200 {array} dtos.OrderDto
-// @Failure 500 {string} string "Ошибка сервера"
+// @Header 200 {string} Link "Ссылка на следующую страницу (rel=next)"
+// @Failure 400 {object} dtos.ProblemDto "Некорректный курсор"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
 // @Router /orders [get]
 func (o *OrdersHandler) Get(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -46,15 +50,21 @@ func (o *OrdersHandler) Get(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	claims, _ := middleware.GetClaimsFromCtx(ctx)
 	userID := r.URL.Query().Get("user_id")
 
-	fmt.Println(userID)
-	orders, err := o.service.Get(ctx, paginationParams.Offset, paginationParams.Limit, userID)
+	page, err := o.service.Get(ctx, paginationParams.Offset, paginationParams.Limit, paginationParams.Cursor, userID, claims)
 	if err != nil {
-		http.Error(w, "Не удалось получить заказы", http.StatusInternalServerError)
+		writeError(w, r, err, "Не удалось получить заказы")
 		return
 	}
 
+	orders := make([]dtos.OrderDto, 0, len(page.Orders))
+	for _, order := range page.Orders {
+		orders = append(orders, toOrderDto(order))
+	}
+
+	setCursorLinkHeader(w, r, paginationParams.Limit, page.NextCursor)
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(orders)
 }
@@ -67,112 +77,224 @@ func (o *OrdersHandler) Get(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param id path string true "ID заказа"
 // @Success 200 {object} dtos.OrderDto
-// @Failure 400 {string} string "Некорректный ID"
-// @Failure 404 {string} string "Заказ не найден"
+// @Failure 400 {object} dtos.ProblemDto "Некорректный ID"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 404 {object} dtos.ProblemDto "Заказ не найден"
+// @Security BearerAuth
 // @Router /orders/{id} [get]
 func (o *OrdersHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		http.Error(w, "Не передан id заказа", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Не передан id заказа")
 		return
 	}
 
-	order, err := o.service.GetByID(ctx, id)
+	claims, _ := middleware.GetClaimsFromCtx(ctx)
+	order, err := o.service.GetByID(ctx, id, claims)
 	if err != nil {
-		http.Error(w, "Заказ не найден", http.StatusNotFound)
+		writeError(w, r, err, "Ошибка при получении заказа")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(order)
+	json.NewEncoder(w).Encode(toOrderDto(order))
 }
 
 // CreateOrder godoc
 // @Summary Создать заказ
-// @Description Добавляет новый заказ
+// @Description Добавляет новый заказ от имени текущего пользователя. Сотрудник может указать user_id другого пользователя.
+// @Description Цена за единицу, суммы позиций и итог рассчитываются по текущему каталогу: позиция с offer_id - по предложению поставщика, без него - по цене продукта.
+// @Description Остатки предложений резервируются под заказ до reserved_until; резерв списывается при оплате и снимается при отмене или по истечении срока.
+// @Description После резерва и авторизации платежа заказ переходит в confirmed. При временной недоступности склада или платежей заказ возвращается в pending и оформляется в фоне.
 // @Tags orders
 // @Accept  json
 // @Produce  json
 // @Param order body dtos.CreateOrderDto true "Данные нового заказа"
 // @Success 201 {object} dtos.OrderDto
-// @Failure 400 {string} string "Неверные данные"
-// @Failure 500 {string} string "Ошибка сервера"
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные, неизвестный продукт или предложение"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 409 {object} dtos.ProblemDto "Недостаточно товара в наличии или платеж отклонен"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Failure 503 {object} dtos.ProblemDto "Сервис продуктов недоступен"
+// @Security BearerAuth
 // @Router /orders [post]
 func (o *OrdersHandler) Post(w http.ResponseWriter, r *http.Request) {
 	var dto dtos.CreateOrderDto
 	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
-		http.Error(w, "Ошибка при разборе JSON", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
 		return
 	}
 
-	order := models.Order{
-		UserID:      dto.UserID,
-		ProductsIDs: dto.ProductsIDs,
-		Total:       dto.Total,
+	claims, _ := middleware.GetClaimsFromCtx(r.Context())
+	userID := claims.UserID
+	if claims.IsStaff() && dto.UserID != "" {
+		userID = dto.UserID
 	}
 
-	createdOrder, err := o.service.Create(order)
+	order := models.Order{UserID: userID}
+	for _, item := range dto.Items {
+		order.Items = append(order.Items, models.OrderItem{
+			ProductID: item.ProductID,
+			OfferID:   item.OfferID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	createdOrder, err := o.service.Create(order, claims)
 	if err != nil {
-		http.Error(w, "Ошибка при создании заказа", http.StatusInternalServerError)
+		writeError(w, r, err, "Ошибка при создании заказа")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(createdOrder)
+	json.NewEncoder(w).Encode(toOrderDto(createdOrder))
 }
 
 // UpdateOrder godoc
-// @Summary Обновить заказ
-// @Description Обновляет существующий заказ
+// @Summary Изменить статус заказа
+// @Description Переводит заказ в новый статус: pending -> confirmed -> paid -> shipped -> delivered, отмена (cancelled) до оплаты, возврат (refunded) после оплаты.
+// @Description Покупатель может только отменить свой заказ, остальные переходы выполняют сотрудники. Переход сохраняется в истории статусов.
 // @Tags orders
 // @Accept  json
 // @Produce  json
-// @Param order body dtos.OrderDto true "Обновленные данные заказа"
+// @Param id path string true "ID заказа"
+// @Param order body dtos.UpdateOrderStatusDto true "Новый статус"
 // @Success 200 {object} dtos.OrderDto
-// @Failure 400 {string} string "Неверные данные"
-// @Failure 500 {string} string "Ошибка сервера"
-// @Router /orders [put]
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Переход доступен только сотрудникам"
+// @Failure 404 {object} dtos.ProblemDto "Заказ не найден"
+// @Failure 409 {object} dtos.ProblemDto "Недопустимый переход, заказ еще оформляется, резерв заказа истек или статус изменился параллельно"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
+// @Router /orders/{id} [put]
 func (o *OrdersHandler) Put(w http.ResponseWriter, r *http.Request) {
-	var order models.Order
-	if err := json.NewDecoder(r.Body).Decode(&order); err != nil {
-		http.Error(w, "Ошибка при разборе JSON", http.StatusBadRequest)
+	var dto dtos.UpdateOrderStatusDto
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
+		return
+	}
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeProblem(w, r, http.StatusBadRequest, "Не передан id заказа")
+		return
+	}
+
+	claims, _ := middleware.GetClaimsFromCtx(r.Context())
+	updatedOrder, err := o.service.UpdateStatus(id, dto.Status, dto.Comment, claims)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при обновлении заказа")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(toOrderDto(updatedOrder))
+}
+
+// GetOrderHistory godoc
+// @Summary История статусов заказа
+// @Description Возвращает изменения статуса заказа в хронологическом порядке, начиная с создания, с автором и временем
+// @Tags orders
+// @Produce  json
+// @Param id path string true "ID заказа"
+// @Success 200 {array} dtos.StatusChangeDto
+// @Failure 400 {object} dtos.ProblemDto "Некорректный ID"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 404 {object} dtos.ProblemDto "Заказ не найден"
+// @Security BearerAuth
+// @Router /orders/{id}/history [get]
+func (o *OrdersHandler) History(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeProblem(w, r, http.StatusBadRequest, "Не передан id заказа")
 		return
 	}
 
-	updatedOrder, err := o.service.Update(order)
+	claims, _ := middleware.GetClaimsFromCtx(ctx)
+	history, err := o.service.History(ctx, id, claims)
 	if err != nil {
-		http.Error(w, "Ошибка при обновлении заказа", http.StatusInternalServerError)
+		writeError(w, r, err, "Ошибка при получении истории заказа")
 		return
 	}
 
+	changes := make([]dtos.StatusChangeDto, 0, len(history))
+	for _, change := range history {
+		changes = append(changes, dtos.StatusChangeDto{
+			From:      change.From,
+			To:        change.To,
+			ActorID:   change.ActorID,
+			ActorRole: change.ActorRole,
+			Comment:   change.Comment,
+			ChangedAt: change.ChangedAt,
+		})
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(updatedOrder)
+	json.NewEncoder(w).Encode(changes)
 }
 
 // DeleteOrder godoc
 // @Summary Удалить заказ
-// @Description Удаляет заказ по ID
+// @Description Удаляет заказ по ID. Покупатель может удалить свой заказ только в статусе pending или cancelled, сотрудник - любой заказ. Резерв неоплаченного заказа возвращается в остатки, авторизация платежа снимается.
 // @Tags orders
 // @Accept  json
 // @Produce  json
 // @Param id path string true "ID заказа"
 // @Success 204 "Заказ удален"
-// @Failure 400 {string} string "Некорректный ID"
-// @Failure 500 {string} string "Ошибка сервера"
+// @Failure 400 {object} dtos.ProblemDto "Некорректный ID"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Заказ в этом статусе может удалить только сотрудник"
+// @Failure 404 {object} dtos.ProblemDto "Заказ не найден"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Failure 503 {object} dtos.ProblemDto "Сервис продуктов или платежей недоступен"
+// @Security BearerAuth
 // @Router /orders/{id} [delete]
 func (o *OrdersHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		http.Error(w, "Не передан id заказа", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Не передан id заказа")
 		return
 	}
 
-	if err := o.service.Delete(id); err != nil {
-		http.Error(w, "Ошибка при удалении заказа", http.StatusInternalServerError)
+	claims, _ := middleware.GetClaimsFromCtx(r.Context())
+	err := o.service.Delete(id, claims)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при удалении заказа")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// toOrderDto - преобразование заказа в DTO ответа
+func toOrderDto(order models.Order) dtos.OrderDto {
+	dto := dtos.OrderDto{
+		ID:        order.ID,
+		UserID:    order.UserID,
+		Status:    order.Status,
+		Items:     make([]dtos.OrderItemDto, 0, len(order.Items)),
+		Subtotal:  order.Subtotal,
+		Total:     order.Total,
+		Currency:  order.Currency,
+		CreatedAt: order.CreatedAt,
+		UpdatedAt: order.UpdatedAt,
+	}
+	if !order.ReservedUntil.IsZero() {
+		dto.ReservedUntil = &order.ReservedUntil
+	}
+	for _, item := range order.Items {
+		dto.Items = append(dto.Items, dtos.OrderItemDto{
+			ProductID: item.ProductID,
+			OfferID:   item.OfferID,
+			Supplier:  item.Supplier,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			LineTotal: item.LineTotal,
+		})
+	}
+	return dto
+}
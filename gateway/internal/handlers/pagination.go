@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// setPaginationHeaders - заголовки X-Total-Count и Link (RFC 8288) для постраничного списка
+func setPaginationHeaders(w http.ResponseWriter, r *http.Request, offset, limit int, total int64, hasNext bool) {
+	w.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+
+	var links []string
+	addLink := func(rel string, offset int) {
+		links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, pageURL(r.URL, offset, limit), rel))
+	}
+
+	addLink("first", 0)
+	if offset > 0 {
+		addLink("prev", max(offset-limit, 0))
+	}
+	if hasNext {
+		addLink("next", offset+limit)
+	}
+	if total > 0 {
+		addLink("last", int((total-1)/int64(limit))*limit)
+	}
+
+	w.Header().Set("Link", strings.Join(links, ", "))
+}
+
+// pageURL - относительная ссылка на страницу с сохранением остальных параметров запроса
+func pageURL(u *url.URL, offset, limit int) string {
+	query := u.Query()
+	query.Set("offset", strconv.Itoa(offset))
+	query.Set("limit", strconv.Itoa(limit))
+	return (&url.URL{Path: u.Path, RawQuery: query.Encode()}).String()
+}
+
+// setCursorLinkHeader - заголовок Link со ссылкой на следующую страницу курсорной пагинации
+func setCursorLinkHeader(w http.ResponseWriter, r *http.Request, limit int, nextCursor string) {
+	if nextCursor == "" {
+		return
+	}
+	query := r.URL.Query()
+	query.Del("offset")
+	query.Set("cursor", nextCursor)
+	query.Set("limit", strconv.Itoa(limit))
+	next := (&url.URL{Path: r.URL.Path, RawQuery: query.Encode()}).String()
+	w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next))
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"encoding/json"
+	"gateway/internal/dtos"
+	"gateway/internal/models"
+	"gateway/internal/services"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// PartsHandler - обработчик поиска запчастей по артикулу
+type PartsHandler struct {
+	service *services.ProductsService
+}
+
+// NewPartsHandler - конструктор обработчика поиска по артикулу
+func NewPartsHandler(service *services.ProductsService) *PartsHandler {
+	return &PartsHandler{service: service}
+}
+
+// FindByArticle godoc
+// @Summary Найти запчасть по артикулу
+// @Description Возвращает продукты с артикулом (номер OEM или производителя) и их аналоги по таблице кроссов.
+// @Description Пробелы, дефисы и регистр в артикуле не учитываются: 04152-YZZA1 и 04152yzza1 - один артикул.
+// @Tags parts
+// @Produce  json
+// @Param article path string true "Артикул"
+// @Param brand query string false "Производитель"
+// @Success 200 {object} dtos.PartDto
+// @Failure 400 {object} dtos.ProblemDto "Некорректный артикул"
+// @Failure 404 {object} dtos.ProblemDto "Ни продукт, ни аналоги не найдены"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Router /parts/{article} [get]
+func (h *PartsHandler) FindByArticle(w http.ResponseWriter, r *http.Request) {
+	article := chi.URLParam(r, "article")
+	if strings.TrimSpace(article) == "" {
+		writeProblem(w, r, http.StatusBadRequest, "Не передан артикул")
+		return
+	}
+
+	match, err := h.service.FindByArticle(r.Context(), article, r.URL.Query().Get("brand"))
+	if err != nil {
+		writeError(w, r, err, "Ошибка при поиске по артикулу")
+		return
+	}
+
+	resp := dtos.PartDto{
+		ArticleNormalized: match.ArticleNormalized,
+		Exact:             make([]dtos.ProductDto, 0, len(match.Exact)),
+		Analogs:           make([]dtos.ProductDto, 0, len(match.Analogs)),
+	}
+	for _, product := range match.Exact {
+		resp.Exact = append(resp.Exact, toProductDto(product))
+	}
+	for _, product := range match.Analogs {
+		resp.Analogs = append(resp.Analogs, toProductDto(product))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// AddCrossReferences godoc
+// @Summary Добавить кроссы
+// @Description Добавляет пары взаимозаменяемых артикулов. Кросс действует в обе стороны, уже существующие пары пропускаются.
+// @Tags parts
+// @Accept  json
+// @Produce  json
+// @Param crossrefs body []dtos.CrossReferenceDto true "Кроссы"
+// @Success 200 {object} dtos.AddCrossReferencesDto
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Недостаточно прав"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
+// @Router /parts/crossrefs [post]
+func (h *PartsHandler) AddCrossReferences(w http.ResponseWriter, r *http.Request) {
+	var dto []dtos.CrossReferenceDto
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
+		return
+	}
+
+	refs := make([]models.CrossReference, 0, len(dto))
+	for _, ref := range dto {
+		refs = append(refs, models.CrossReference{
+			Brand:         ref.Brand,
+			Article:       ref.Article,
+			AnalogBrand:   ref.AnalogBrand,
+			AnalogArticle: ref.AnalogArticle,
+		})
+	}
+
+	added, err := h.service.AddCrossReferences(r.Context(), refs)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при добавлении кроссов")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(dtos.AddCrossReferencesDto{Added: added})
+}
@@ -8,6 +8,9 @@ import (
 	"gateway/internal/models"
 	"gateway/internal/services"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 )
@@ -25,15 +28,26 @@ func NewProductsHandler(service services.ProductsService) *ProductsHandler {
 }
 
 // GetProducts godoc
-// @Summary Получить список продуктов
-// @Description Возвращает список продуктов с возможностью пагинации
+// @Summary Получить или найти продукты
+// @Description Возвращает страницу продуктов. При заданных q, category, min_price, max_price, sort, vehicle или attr.<название> выполняется поиск.
+// @Description Параметр vehicle задается в формате марка:модель[:год[:код двигателя[:кузов]]], например vehicle=Toyota:Camry:2012.
+// @Description Общее количество передается в заголовке X-Total-Count, ссылки на соседние страницы - в заголовке Link.
 // @Tags products
 // @Accept  json
 // @Produce  json
 // @Param offset query int false "offset" default(0)
 // @Param limit query int false "Items per page" default(10)
+// @Param q query string false "Полнотекстовый запрос по названию и описанию"
+// @Param category query string false "Категория"
+// @Param min_price query number false "Минимальная цена"
+// @Param max_price query number false "Максимальная цена"
+// @Param sort query string false "Сортировка" Enums(relevance, price_asc, price_desc, newest)
+// @Param vehicle query string false "Автомобиль, которому должны подходить продукты"
 // @Success 200 {array} dtos.ProductDto
-// @Failure 500 {string} string "Ошибка сервера"
+// @Header 200 {integer} X-Total-Count "Общее количество продуктов"
+// @Header 200 {string} Link "Ссылки на страницы first, prev, next, last"
+// @Failure 400 {object} dtos.ProblemDto "Неверные параметры поиска или пагинации"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
 // @Router /products [get]
 func (p *ProductsHandler) Get(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -45,16 +59,33 @@ func (p *ProductsHandler) Get(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Вызываем сервис
-	products, err := p.service.Get(ctx, paginationParams.Offset, paginationParams.Limit)
+	filter, isSearch, err := productFilterFromQuery(r.URL.Query())
 	if err != nil {
-		http.Error(w, "Не удалось получить продукты", http.StatusInternalServerError)
+		writeProblem(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
+	// Вызываем сервис: поиск, если заданы параметры фильтрации, иначе обычный список
+	var page models.ProductsPage
+	if isSearch {
+		page, err = p.service.Search(ctx, filter, paginationParams.Offset, paginationParams.Limit)
+	} else {
+		page, err = p.service.Get(ctx, paginationParams.Offset, paginationParams.Limit)
+	}
+	if err != nil {
+		writeError(w, r, err, "Не удалось получить продукты")
+		return
+	}
+
+	resp := make([]dtos.ProductDto, 0, len(page.Products))
+	for _, product := range page.Products {
+		resp = append(resp, toProductDto(product))
+	}
+
 	// Преобразуем в JSON и отправляем ответ
+	setPaginationHeaders(w, r, paginationParams.Offset, paginationParams.Limit, page.Total, page.HasNext)
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(products)
+	json.NewEncoder(w).Encode(resp)
 }
 
 // GetProductByID godoc
@@ -65,8 +96,8 @@ func (p *ProductsHandler) Get(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param id path string true "ID продукта"
 // @Success 200 {object} dtos.ProductDto
-// @Failure 400 {string} string "Некорректный ID"
-// @Failure 404 {string} string "Продукт не найден"
+// @Failure 400 {object} dtos.ProblemDto "Некорректный ID"
+// @Failure 404 {object} dtos.ProblemDto "Продукт не найден"
 // @Router /products/{id} [get]
 func (p *ProductsHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -74,18 +105,18 @@ func (p *ProductsHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	fmt.Println(id)
 	fmt.Println(r.URL)
 	if id == "" {
-		http.Error(w, "Не передан id продукта", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Не передан id продукта")
 		return
 	}
 
 	product, err := p.service.GetByID(ctx, id)
 	if err != nil {
-		http.Error(w, "Продукт не найден", http.StatusNotFound)
+		writeError(w, r, err, "Ошибка при получении продукта")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(product)
+	json.NewEncoder(w).Encode(toProductDto(product))
 }
 
 // CreateProduct godoc
@@ -96,13 +127,16 @@ func (p *ProductsHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param product body dtos.CreateProductDto true "Данные нового продукта"
 // @Success 201 {object} dtos.ProductDto
-// @Failure 400 {string} string "Неверные данные"
-// @Failure 500 {string} string "Ошибка сервера"
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Недостаточно прав"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
 // @Router /products [post]
 func (p *ProductsHandler) Post(w http.ResponseWriter, r *http.Request) {
 	var dto dtos.CreateProductDto
 	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
-		http.Error(w, "Ошибка при разборе JSON", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
 		return
 	}
 
@@ -111,17 +145,21 @@ func (p *ProductsHandler) Post(w http.ResponseWriter, r *http.Request) {
 		Name:        dto.Name,
 		Description: dto.Description,
 		Price:       dto.Price,
+		Category:    dto.Category,
+		Attributes:  dto.Attributes,
+		Brand:       dto.Brand,
+		Article:     dto.Article,
 	}
 
 	createdProduct, err := p.service.Create(product)
 	if err != nil {
-		http.Error(w, "Ошибка при создании продукта", http.StatusInternalServerError)
+		writeError(w, r, err, "Ошибка при создании продукта")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(createdProduct)
+	json.NewEncoder(w).Encode(toProductDto(createdProduct))
 }
 
 // UpdateProduct godoc
@@ -132,24 +170,39 @@ func (p *ProductsHandler) Post(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param product body dtos.ProductDto true "Обновленные данные продукта"
 // @Success 200 {object} dtos.ProductDto
-// @Failure 400 {string} string "Неверные данные"
-// @Failure 500 {string} string "Ошибка сервера"
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Недостаточно прав"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
 // @Router /products [put]
 func (p *ProductsHandler) Put(w http.ResponseWriter, r *http.Request) {
-	var product models.Product
-	if err := json.NewDecoder(r.Body).Decode(&product); err != nil {
-		http.Error(w, "Ошибка при разборе JSON", http.StatusBadRequest)
+	var dto dtos.ProductDto
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
 		return
 	}
 
+	// Время создания и изменения выставляет сервис продуктов
+	product := models.Product{
+		ID:          dto.ID,
+		Name:        dto.Name,
+		Description: dto.Description,
+		Price:       dto.Price,
+		Category:    dto.Category,
+		Attributes:  dto.Attributes,
+		Brand:       dto.Brand,
+		Article:     dto.Article,
+	}
+
 	updatedProduct, err := p.service.Put(product)
 	if err != nil {
-		http.Error(w, "Ошибка при обновлении продукта", http.StatusInternalServerError)
+		writeError(w, r, err, "Ошибка при обновлении продукта")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(updatedProduct)
+	json.NewEncoder(w).Encode(toProductDto(updatedProduct))
 }
 
 // DeleteProduct godoc
@@ -160,20 +213,225 @@ func (p *ProductsHandler) Put(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param id path string true "ID продукта"
 // @Success 204 "Продукт удален"
-// @Failure 400 {string} string "Некорректный ID"
-// @Failure 500 {string} string "Ошибка сервера"
+// @Failure 400 {object} dtos.ProblemDto "Некорректный ID"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Недостаточно прав"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
 // @Router /products/{id} [delete]
 func (p *ProductsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		http.Error(w, "Не передан id продукта", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Не передан id продукта")
 		return
 	}
 
 	if err := p.service.Delete(id); err != nil {
-		http.Error(w, "Ошибка при удалении продукта", http.StatusInternalServerError)
+		writeError(w, r, err, "Ошибка при удалении продукта")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// GetFitments godoc
+// @Summary Получить применимость продукта
+// @Description Возвращает список автомобилей, к которым подходит продукт
+// @Tags products
+// @Produce  json
+// @Param id path string true "ID продукта"
+// @Success 200 {array} dtos.FitmentDto
+// @Failure 400 {object} dtos.ProblemDto "Некорректный ID"
+// @Failure 404 {object} dtos.ProblemDto "Продукт не найден"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Router /products/{id}/fitments [get]
+func (p *ProductsHandler) GetFitments(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeProblem(w, r, http.StatusBadRequest, "Не передан id продукта")
+		return
+	}
+
+	fitments, err := p.service.GetFitments(r.Context(), id)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при получении применимости продукта")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(toFitmentDtos(fitments))
+}
+
+// PutFitments godoc
+// @Summary Заменить применимость продукта
+// @Description Полностью заменяет список автомобилей, к которым подходит продукт. Пустой список удаляет применимость.
+// @Tags products
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID продукта"
+// @Param fitments body []dtos.FitmentDto true "Новая применимость"
+// @Success 200 {array} dtos.FitmentDto
+// @Failure 400 {object} dtos.ProblemDto "Неверные данные"
+// @Failure 401 {object} dtos.ProblemDto "Требуется авторизация"
+// @Failure 403 {object} dtos.ProblemDto "Недостаточно прав"
+// @Failure 404 {object} dtos.ProblemDto "Продукт не найден"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Security BearerAuth
+// @Router /products/{id}/fitments [put]
+func (p *ProductsHandler) PutFitments(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeProblem(w, r, http.StatusBadRequest, "Не передан id продукта")
+		return
+	}
+
+	var dto []dtos.FitmentDto
+	if err := json.NewDecoder(r.Body).Decode(&dto); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "Ошибка при разборе JSON")
+		return
+	}
+
+	fitments := make([]models.Fitment, 0, len(dto))
+	for _, f := range dto {
+		fitments = append(fitments, models.Fitment{
+			Make:       f.Make,
+			Model:      f.Model,
+			Generation: f.Generation,
+			YearFrom:   f.YearFrom,
+			YearTo:     f.YearTo,
+			EngineCode: f.EngineCode,
+			Body:       f.Body,
+		})
+	}
+
+	saved, err := p.service.SetFitments(r.Context(), id, fitments)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при изменении применимости продукта")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(toFitmentDtos(saved))
+}
+
+// toFitmentDtos - преобразование применимости в DTO ответа
+func toFitmentDtos(fitments []models.Fitment) []dtos.FitmentDto {
+	resp := make([]dtos.FitmentDto, 0, len(fitments))
+	for _, f := range fitments {
+		resp = append(resp, dtos.FitmentDto{
+			ID:         f.ID,
+			Make:       f.Make,
+			Model:      f.Model,
+			Generation: f.Generation,
+			YearFrom:   f.YearFrom,
+			YearTo:     f.YearTo,
+			EngineCode: f.EngineCode,
+			Body:       f.Body,
+		})
+	}
+	return resp
+}
+
+// toProductDto - преобразование модели продукта в DTO ответа
+func toProductDto(product models.Product) dtos.ProductDto {
+	return dtos.ProductDto{
+		ID:          product.ID,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+		Category:    product.Category,
+		Attributes:  product.Attributes,
+		Brand:       product.Brand,
+		Article:     product.Article,
+		CreatedAt:   product.CreatedAt,
+		UpdatedAt:   product.UpdatedAt,
+
+		ArticleNormalized: product.ArticleNormalized,
+		BestOffer:         toOfferDto(product.BestOffer),
+		OfferCount:        product.OfferCount,
+	}
+}
+
+// attributeParamPrefix - префикс параметров запроса для фильтра по характеристикам, например attr.brand=Bosch
+const attributeParamPrefix = "attr."
+
+// productFilterFromQuery - разбор параметров поиска продуктов.
+// Возвращает признак того, что задан хотя бы один параметр поиска.
+func productFilterFromQuery(query url.Values) (models.ProductFilter, bool, error) {
+	filter := models.ProductFilter{
+		Query:    strings.TrimSpace(query.Get("q")),
+		Category: query.Get("category"),
+		Sort:     query.Get("sort"),
+	}
+
+	var err error
+	if filter.MinPrice, err = parsePrice(query, "min_price"); err != nil {
+		return filter, false, err
+	}
+	if filter.MaxPrice, err = parsePrice(query, "max_price"); err != nil {
+		return filter, false, err
+	}
+	if filter.Vehicle, err = parseVehicle(query.Get("vehicle")); err != nil {
+		return filter, false, err
+	}
+
+	for key, values := range query {
+		name, ok := strings.CutPrefix(key, attributeParamPrefix)
+		if !ok || name == "" || len(values) == 0 {
+			continue
+		}
+		if filter.Attributes == nil {
+			filter.Attributes = make(map[string]string)
+		}
+		filter.Attributes[name] = values[0]
+	}
+
+	isSearch := filter.Query != "" || filter.Category != "" || filter.Sort != "" ||
+		filter.MinPrice != nil || filter.MaxPrice != nil || filter.Vehicle != nil || len(filter.Attributes) > 0
+	return filter, isSearch, nil
+}
+
+func parsePrice(query url.Values, key string) (*float32, error) {
+	value := query.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+	price, err := strconv.ParseFloat(value, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%s: ожидалось число, получено %q", key, value)
+	}
+	p := float32(price)
+	return &p, nil
+}
+
+// parseVehicle - разбор автомобиля в формате марка:модель[:год[:код двигателя[:кузов]]]
+func parseVehicle(value string) (*models.Vehicle, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 5 {
+		return nil, fmt.Errorf("vehicle: ожидался формат марка:модель[:год[:код двигателя[:кузов]]], получено %q", value)
+	}
+	for len(parts) < 5 {
+		parts = append(parts, "")
+	}
+
+	vehicle := &models.Vehicle{
+		Make:       strings.TrimSpace(parts[0]),
+		Model:      strings.TrimSpace(parts[1]),
+		EngineCode: strings.TrimSpace(parts[3]),
+		Body:       strings.TrimSpace(parts[4]),
+	}
+	if vehicle.Make == "" || vehicle.Model == "" {
+		return nil, fmt.Errorf("vehicle: марка и модель обязательны, получено %q", value)
+	}
+	if year := strings.TrimSpace(parts[2]); year != "" {
+		y, err := strconv.Atoi(year)
+		if err != nil {
+			return nil, fmt.Errorf("vehicle: год должен быть числом, получено %q", year)
+		}
+		vehicle.Year = y
+	}
+	return vehicle, nil
+}
@@ -29,9 +29,14 @@ func NewUserHandler(service *services.UsersService) *UserHandler {
 // @Produce  json
 // @Param offset query int false "offset" default(0)
 // @Param limit query int false "Items per page" default(10)
+// @Param cursor query string false "Курсор следующей страницы из заголовка Link"
 // @Success 200 {array} dtos.UserDto
-// @Failure 400 {object} string "Bad request"
-// @Failure 500 {object} string "Server error"
+// @Header 200 {string} Link "Ссылка на следующую страницу (rel=next)"
+// @Failure 400 {object} dtos.ProblemDto "Bad request"
+// @Failure 401 {object} dtos.ProblemDto "Unauthorized"
+// @Failure 403 {object} dtos.ProblemDto "Forbidden"
+// @Failure 500 {object} dtos.ProblemDto "Server error"
+// @Security BearerAuth
 // @Router /users [get]
 func (h *UserHandler) GetUsers(w http.ResponseWriter, r *http.Request) {
 	// Получаем параметры пагинации из запроса
@@ -44,15 +49,16 @@ func (h *UserHandler) GetUsers(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Запрашиваем список пользователей с пагинацией
-	users, err := h.service.GetUsers(r.Context(), paginationParams.Offset, paginationParams.Limit)
+	page, err := h.service.GetUsers(r.Context(), paginationParams.Offset, paginationParams.Limit, paginationParams.Cursor)
 	if err != nil {
-		http.Error(w, "Error retrieving users", http.StatusInternalServerError)
+		writeError(w, r, err, "Error retrieving users")
 		return
 	}
 
 	// Отправляем результат
+	setCursorLinkHeader(w, r, paginationParams.Limit, page.NextCursor)
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(users)
+	json.NewEncoder(w).Encode(page.Users)
 }
 
 // GetUserByID godoc
@@ -63,8 +69,8 @@ func (h *UserHandler) GetUsers(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param id path string true "User ID"
 // @Success 200 {object} dtos.UserDto
-// @Failure 400 {object} string "Bad request"
-// @Failure 404 {object} string "Not found"
+// @Failure 400 {object} dtos.ProblemDto "Bad request"
+// @Failure 404 {object} dtos.ProblemDto "Not found"
 // @Router /users/{id} [get]
 func (h *UserHandler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -72,7 +78,7 @@ func (h *UserHandler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 
 	user, err := h.service.GetUserByID(ctx, id)
 	if err != nil {
-		http.Error(w, "User not found", http.StatusNotFound)
+		writeError(w, r, err, "Error retrieving user")
 		return
 	}
 
@@ -88,13 +94,14 @@ func (h *UserHandler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param user body dtos.CreateUserDto true "User Data"
 // @Success 201 {object} dtos.UserDto
-// @Failure 400 {object} string "Bad request"
-// @Failure 500 {object} string "Server error"
+// @Failure 400 {object} dtos.ProblemDto "Bad request"
+// @Failure 409 {object} dtos.ProblemDto "User already exists"
+// @Failure 500 {object} dtos.ProblemDto "Server error"
 // @Router /users [post]
 func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	var req dtos.CreateUserDto
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Invalid request")
 		return
 	}
 
@@ -103,12 +110,12 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 		Username:  req.Username,
 		Password:  req.Password,
 		Confirmed: false,
-		Role:      "USER",
+		Role:      models.RoleCustomer,
 	}
 
 	createdUser, err := h.service.CreateUser(r.Context(), *user)
 	if err != nil {
-		http.Error(w, "Error creating user", http.StatusInternalServerError)
+		writeError(w, r, err, "Error creating user")
 		return
 	}
 
@@ -119,25 +126,35 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 // UpdateUser godoc
 // @Summary Обновить данные пользователя
-// @Description Обновление данных пользователя по ID
+// @Description Обновление имени и пароля пользователя по ID. Пустые поля не меняются; email, роль и блокировка этим запросом не меняются
 // @Tags users
 // @Accept  json
 // @Produce  json
 // @Param id path string true "User ID"
 // @Param user body dtos.CreateUserDto true "Updated User Data"
 // @Success 200 {object} dtos.UserDto
-// @Failure 400 {object} string "Bad request"
-// @Failure 404 {object} string "Not found"
-// @Failure 500 {object} string "Server error"
+// @Failure 400 {object} dtos.ProblemDto "Bad request"
+// @Failure 401 {object} dtos.ProblemDto "Unauthorized"
+// @Failure 403 {object} dtos.ProblemDto "Forbidden"
+// @Failure 404 {object} dtos.ProblemDto "Not found"
+// @Failure 500 {object} dtos.ProblemDto "Server error"
+// @Security BearerAuth
 // @Router /users/{id} [put]
 func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	// Извлекаем ID пользователя из URL-параметра
 	id := chi.URLParam(r, "id")
 
+	// Изменять данные может только сам пользователь или администратор
+	claims, ok := middleware.GetClaimsFromCtx(r.Context())
+	if !ok || (claims.UserID != id && !claims.HasRole(models.RoleAdmin)) {
+		writeProblem(w, r, http.StatusForbidden, "Forbidden")
+		return
+	}
+
 	// Декодируем тело запроса в структуру CreateUserDto
 	var req dtos.CreateUserDto
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Invalid request")
 		return
 	}
 
@@ -151,12 +168,7 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	// Вызываем метод сервиса для обновления пользователя по ID
 	updatedUser, err := h.service.UpdateUser(r.Context(), id, *user)
 	if err != nil {
-		// TODO: errors typization
-		if err.Error() == "User not found" {
-			http.Error(w, "User not found", http.StatusNotFound)
-		} else {
-			http.Error(w, "Error updating user", http.StatusInternalServerError)
-		}
+		writeError(w, r, err, "Error updating user")
 		return
 	}
 
@@ -173,20 +185,23 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 // @Produce  json
 // @Param id path string true "User ID"
 // @Success 204 {string} string "User deleted successfully"
-// @Failure 400 {object} string "Bad request"
-// @Failure 404 {object} string "Not found"
+// @Failure 400 {object} dtos.ProblemDto "Bad request"
+// @Failure 401 {object} dtos.ProblemDto "Unauthorized"
+// @Failure 403 {object} dtos.ProblemDto "Forbidden"
+// @Failure 404 {object} dtos.ProblemDto "Not found"
+// @Security BearerAuth
 // @Router /users/{id} [delete]
 func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		http.Error(w, "Expected id url param", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "Expected id url param")
 		return
 	}
 
 	err := h.service.DeleteUser(ctx, id)
 	if err != nil {
-		http.Error(w, "User not found", http.StatusNotFound)
+		writeError(w, r, err, "Error deleting user")
 		return
 	}
 
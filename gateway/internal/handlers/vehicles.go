@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"gateway/internal/services"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// VehiclesHandler - обработчик справочника автомобилей
+type VehiclesHandler struct {
+	service *services.ProductsService
+}
+
+// NewVehiclesHandler - конструктор обработчика справочника автомобилей
+func NewVehiclesHandler(service *services.ProductsService) *VehiclesHandler {
+	return &VehiclesHandler{service: service}
+}
+
+// Makes godoc
+// @Summary Получить список марок
+// @Description Возвращает марки автомобилей, для которых в каталоге есть запчасти
+// @Tags vehicles
+// @Produce  json
+// @Success 200 {array} string
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Router /vehicles/makes [get]
+func (h *VehiclesHandler) Makes(w http.ResponseWriter, r *http.Request) {
+	makes, err := h.service.ListMakes(r.Context())
+	if err != nil {
+		writeError(w, r, err, "Ошибка при получении списка марок")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(makes)
+}
+
+// Models godoc
+// @Summary Получить список моделей марки
+// @Description Возвращает модели марки, для которых в каталоге есть запчасти. Регистр названия марки не учитывается.
+// @Tags vehicles
+// @Produce  json
+// @Param make path string true "Марка"
+// @Success 200 {array} string
+// @Failure 400 {object} dtos.ProblemDto "Не передана марка"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Router /vehicles/{make}/models [get]
+func (h *VehiclesHandler) Models(w http.ResponseWriter, r *http.Request) {
+	vehicleMake := strings.TrimSpace(chi.URLParam(r, "make"))
+	if vehicleMake == "" {
+		writeProblem(w, r, http.StatusBadRequest, "Не передана марка")
+		return
+	}
+
+	models, err := h.service.ListModels(r.Context(), vehicleMake)
+	if err != nil {
+		writeError(w, r, err, "Ошибка при получении списка моделей")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(models)
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"gateway/internal/dtos"
+	"gateway/internal/middleware"
+	"gateway/internal/models"
+	"gateway/internal/services"
+	"gateway/internal/vin"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// VinHandler - обработчик декодирования VIN
+type VinHandler struct {
+	decoder  *vin.Decoder
+	products *services.ProductsService
+}
+
+// NewVinHandler - конструктор обработчика декодирования VIN
+func NewVinHandler(decoder *vin.Decoder, products *services.ProductsService) *VinHandler {
+	return &VinHandler{decoder: decoder, products: products}
+}
+
+// Decode godoc
+// @Summary Расшифровать VIN
+// @Description Определяет производителя, модель и модельный год по VIN. Для североамериканских VIN проверяется контрольная цифра.
+// @Description С products=true возвращает подходящие продукты, если удалось определить марку и модель; пагинация продуктов передается в заголовках X-Total-Count и Link.
+// @Tags vehicles
+// @Produce  json
+// @Param vin path string true "VIN"
+// @Param products query bool false "Подобрать подходящие продукты" default(false)
+// @Param offset query int false "offset" default(0)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} dtos.VinDto
+// @Header 200 {integer} X-Total-Count "Общее количество подходящих продуктов"
+// @Header 200 {string} Link "Ссылки на страницы first, prev, next, last"
+// @Failure 400 {object} dtos.ProblemDto "Некорректный VIN"
+// @Failure 500 {object} dtos.ProblemDto "Ошибка сервера"
+// @Router /vin/{vin} [get]
+func (h *VinHandler) Decode(w http.ResponseWriter, r *http.Request) {
+	info, err := h.decoder.Decode(chi.URLParam(r, "vin"))
+	if err != nil {
+		if errors.Is(err, vin.ErrInvalidLength) || errors.Is(err, vin.ErrInvalidChar) || errors.Is(err, vin.ErrChecksum) {
+			writeProblem(w, r, http.StatusBadRequest, err.Error())
+		} else {
+			writeProblem(w, r, http.StatusInternalServerError, "Ошибка при разборе VIN")
+		}
+		return
+	}
+
+	resp := dtos.VinDto{
+		VIN:           info.VIN,
+		WMI:           info.WMI,
+		VDS:           info.VDS,
+		VIS:           info.VIS,
+		Region:        info.Region,
+		NorthAmerican: info.NorthAmerican,
+		Make:          info.Make,
+		Country:       info.Country,
+		Model:         info.Model,
+		Year:          info.Year,
+	}
+
+	withProducts := false
+	if value := r.URL.Query().Get("products"); value != "" {
+		if withProducts, err = strconv.ParseBool(value); err != nil {
+			writeProblem(w, r, http.StatusBadRequest, "products: ожидалось true или false")
+			return
+		}
+	}
+
+	// Продукты подбираются только по известным марке и модели, иначе фильтр вернул бы весь каталог марки
+	if withProducts && info.Make != "" && info.Model != "" {
+		paginationParams, ok := middleware.GetPaginationParamsFromCtx(r.Context())
+		if !ok {
+			paginationParams = &middleware.PaginationParams{
+				Limit:  10,
+				Offset: 0,
+			}
+		}
+
+		filter := models.ProductFilter{
+			Vehicle: &models.Vehicle{
+				Make:  info.Make,
+				Model: info.Model,
+				Year:  info.Year,
+			},
+		}
+		page, err := h.products.Search(r.Context(), filter, paginationParams.Offset, paginationParams.Limit)
+		if err != nil {
+			writeError(w, r, err, "Не удалось получить продукты")
+			return
+		}
+
+		resp.Products = make([]dtos.ProductDto, 0, len(page.Products))
+		for _, product := range page.Products {
+			resp.Products = append(resp.Products, toProductDto(product))
+		}
+		setPaginationHeaders(w, r, paginationParams.Offset, paginationParams.Limit, page.Total, page.HasNext)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
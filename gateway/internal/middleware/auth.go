@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"gateway/internal/models"
+	"gateway/internal/services"
+	"net/http"
+	"strings"
+)
+
+type claimsCtxKey struct{}
+
+// AuthMiddleware - middleware для проверки access-токена из заголовка Authorization.
+// Токен проверяется в сервисе пользователей, данные пользователя кладутся в контекст запроса.
+func AuthMiddleware(authService *services.AuthService) func(http.Handler) http.Handler {
+	return authMiddleware(authService, false)
+}
+
+// OptionalAuthMiddleware - middleware для маршрутов, доступных и гостям.
+// Без заголовка Authorization запрос проходит анонимно, с невалидным токеном - отклоняется.
+func OptionalAuthMiddleware(authService *services.AuthService) func(http.Handler) http.Handler {
+	return authMiddleware(authService, true)
+}
+
+func authMiddleware(authService *services.AuthService, optional bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if optional && r.Header.Get("Authorization") == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, ok := bearerToken(r)
+			if !ok {
+				writeProblem(w, r, http.StatusUnauthorized, "Требуется авторизация")
+				return
+			}
+
+			claims, err := authService.Validate(r.Context(), token)
+			if err != nil {
+				if errors.Is(err, services.ErrInvalidToken) {
+					writeProblem(w, r, http.StatusUnauthorized, "Невалидный токен")
+				} else {
+					writeProblem(w, r, http.StatusServiceUnavailable, "Сервис авторизации недоступен")
+				}
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), claimsCtxKey{}, claims)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireRole - middleware, пропускающий только пользователей с одной из переданных ролей.
+// Должен подключаться после AuthMiddleware.
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := GetClaimsFromCtx(r.Context())
+			if !ok {
+				writeProblem(w, r, http.StatusUnauthorized, "Требуется авторизация")
+				return
+			}
+			if !claims.HasRole(roles...) {
+				writeProblem(w, r, http.StatusForbidden, "Недостаточно прав")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// GetClaimsFromCtx - получение данных авторизованного пользователя из контекста
+func GetClaimsFromCtx(ctx context.Context) (*models.TokenClaims, bool) {
+	c, ok := ctx.Value(claimsCtxKey{}).(*models.TokenClaims)
+	return c, ok
+}
+
+// GetUserIDFromCtx - получение ID авторизованного пользователя из контекста
+func GetUserIDFromCtx(ctx context.Context) (string, bool) {
+	c, ok := GetClaimsFromCtx(ctx)
+	if !ok {
+		return "", false
+	}
+	return c.UserID, true
+}
+
+// bearerToken - извлечение токена из заголовка "Authorization: Bearer <token>"
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
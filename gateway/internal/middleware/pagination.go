@@ -10,8 +10,13 @@ import (
 type PaginationParams struct {
 	Limit  int
 	Offset int
+	// Cursor - непрозрачный курсор следующей страницы, имеет приоритет над Offset
+	Cursor string
 }
 
+// MaxLimit — максимальный размер страницы, совпадает с ограничением сервисов
+const MaxLimit = 100
+
 // PaginationMiddleware — middleware для обработки параметров пагинации
 func PaginationMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -29,12 +34,15 @@ func PaginationMiddleware(next http.Handler) http.Handler {
 		// Читаем `limit` из запроса
 		if l := r.URL.Query().Get("limit"); l != "" {
 			if lInt, err := strconv.Atoi(l); err == nil && lInt > 0 {
-				limit = lInt
+				limit = min(lInt, MaxLimit)
 			}
 		}
 
+		// Читаем `cursor` из запроса
+		cursor := r.URL.Query().Get("cursor")
+
 		// Добавляем параметры в контекст запроса
-		ctx := context.WithValue(r.Context(), "pagination", &PaginationParams{Offset: offset, Limit: limit})
+		ctx := context.WithValue(r.Context(), "pagination", &PaginationParams{Offset: offset, Limit: limit, Cursor: cursor})
 
 		// Передаем управление следующему обработчику
 		next.ServeHTTP(w, r.WithContext(ctx))
@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"encoding/json"
+	"gateway/internal/dtos"
+	"net/http"
+)
+
+// writeProblem - ответ в формате application/problem+json
+func writeProblem(w http.ResponseWriter, r *http.Request, code int, detail string) {
+	w.Header().Set("Content-Type", dtos.ProblemContentType)
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(dtos.NewProblem(code, detail, r.URL.Path))
+}
@@ -9,3 +9,31 @@ type AuthCredentials struct {
 	AccessToken  string
 	RefreshToken string
 }
+
+// Роли пользователей
+const (
+	RoleAdmin    = "admin"
+	RoleManager  = "manager"
+	RoleCustomer = "customer"
+)
+
+// TokenClaims - данные, извлеченные из валидного access-токена
+type TokenClaims struct {
+	UserID string
+	Role   string
+}
+
+// HasRole - проверка, что пользователь обладает одной из переданных ролей
+func (c *TokenClaims) HasRole(roles ...string) bool {
+	for _, role := range roles {
+		if c.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// IsStaff - проверка, что пользователь является сотрудником (администратор или менеджер)
+func (c *TokenClaims) IsStaff() bool {
+	return c.HasRole(RoleAdmin, RoleManager)
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// CartOwner - владелец корзины: авторизованный пользователь или гость
+type CartOwner struct {
+	Claims *TokenClaims
+	// GuestID - идентификатор гостевой корзины, используется без Claims
+	GuestID string
+}
+
+// Cart - корзина с позициями по текущим ценам каталога
+type Cart struct {
+	UserID   string
+	GuestID  string
+	Items    []CartItem
+	Subtotal float64
+	Currency string
+	// Ready - корзина не пуста и все позиции можно заказать
+	Ready     bool
+	UpdatedAt time.Time
+}
+
+// CartItem - позиция корзины
+type CartItem struct {
+	ProductID      string
+	OfferID        string
+	Quantity       int
+	Name           string
+	Supplier       string
+	UnitPrice      float64
+	AddedUnitPrice float64
+	LineTotal      float64
+	// Problem - причина, по которой позицию нельзя заказать
+	Problem string
+	AddedAt time.Time
+}
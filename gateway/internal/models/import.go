@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// ColumnMapping - столбцы прайс-листа: название из заголовка, номер столбца с 1 или буква (A, B, ...)
+type ColumnMapping struct {
+	Article      string
+	Brand        string
+	Name         string
+	Price        string
+	Quantity     string
+	DeliveryDays string
+	Description  string
+	Category     string
+}
+
+// ImportSpec - параметры импорта прайс-листа
+type ImportSpec struct {
+	Supplier string
+	// Format - csv или xlsx
+	Format  string
+	Mapping ColumnMapping
+	// HeaderRow - номер строки заголовка, начиная с 1; 0 - заголовка нет
+	HeaderRow int
+	Sheet     string
+	Delimiter string
+	Currency  string
+}
+
+// ImportRowError - ошибка в строке прайс-листа
+type ImportRowError struct {
+	Row     int
+	Column  string
+	Message string
+}
+
+// ImportJob - задача импорта прайс-листа
+type ImportJob struct {
+	ID       string
+	Supplier string
+	Format   string
+	// Status - pending, running, done или failed
+	Status          string
+	Rows            int64
+	Imported        int64
+	Failed          int64
+	ProductsCreated int64
+	OffersInserted  int64
+	OffersUpdated   int64
+	Errors          []ImportRowError
+	ErrorsTruncated bool
+	Error           string
+	CreatedAt       time.Time
+	FinishedAt      *time.Time
+}
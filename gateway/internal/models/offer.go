@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// Offer - предложение поставщика по продукту
+type Offer struct {
+	ID        string
+	ProductID string
+	Supplier  string
+	Price     float32
+	// Currency - код валюты ISO 4217
+	Currency string
+	// Quantity - остаток поставщика, 0 - нет в наличии
+	Quantity int
+	// Reserved - количество, зарезервированное под заказы
+	Reserved     int
+	DeliveryDays int
+	UpdatedAt    time.Time
+	// Данные объявления для предложений с досок объявлений
+	URL   string
+	Title string
+	// Condition - new, used или contract
+	Condition string
+	ImageURL  string
+	Seller    string
+	Location  string
+}
+
+// ProductWithOffers - продукт со всеми предложениями поставщиков
+type ProductWithOffers struct {
+	Product Product
+	Offers  []Offer
+}
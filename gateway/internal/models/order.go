@@ -3,12 +3,45 @@ package models
 import "time"
 
 type Order struct {
-	ID          string
-	UserID      string
-	ProductsIDs []string
-	Status      string
-	Total       uint
+	ID       string
+	UserID   string
+	Items    []OrderItem
+	Status   string
+	Subtotal float64
+	Total    float64
+	Currency string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// ReservedUntil - срок резерва товаров, нулевой - резерва нет
+	ReservedUntil time.Time
+}
+
+// OrderItem - позиция заказа с ценой на момент оформления
+type OrderItem struct {
+	ProductID string
+	OfferID   string
+	Supplier  string
+	Name      string
+	Quantity  int
+	UnitPrice float64
+	LineTotal float64
+}
+
+// StatusChange - запись истории статусов заказа
+type StatusChange struct {
+	// From - предыдущий статус, пустой для создания заказа
+	From      string
+	To        string
+	ActorID   string
+	ActorRole string
+	Comment   string
+	ChangedAt time.Time
+}
+
+// OrdersPage - страница списка заказов
+type OrdersPage struct {
+	Orders []Order
+	// NextCursor - курсор следующей страницы, пустой на последней странице
+	NextCursor string
 }
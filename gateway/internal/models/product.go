@@ -9,7 +9,57 @@ type Product struct {
 	Price       float32
 	Category    string
 	Attributes  map[string]any
+	// Brand, Article - производитель и артикул запчасти
+	Brand   string
+	Article string
+	// ArticleNormalized - артикул без разделителей в верхнем регистре, выставляется сервисом продуктов
+	ArticleNormalized string
+	// BestOffer - самое дешевое предложение в наличии, nil, если предложений нет
+	BestOffer *Offer
+	// OfferCount - количество предложений в наличии
+	OfferCount int
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// ProductsPage - страница списка продуктов
+type ProductsPage struct {
+	Products []Product
+	// Total - общее количество продуктов
+	Total int64
+	// HasNext - есть ли следующая страница
+	HasNext bool
+}
+
+// ProductFilter - параметры поиска продуктов
+type ProductFilter struct {
+	// Query - полнотекстовый запрос по названию и описанию
+	Query    string
+	Category string
+	MinPrice *float32
+	MaxPrice *float32
+	// Attributes - точное совпадение значений характеристик
+	Attributes map[string]string
+	// Vehicle - только продукты, подходящие автомобилю
+	Vehicle *Vehicle
+	// Sort - relevance, price_asc, price_desc или newest
+	Sort string
+}
+
+// ArticleMatch - результат поиска по артикулу
+type ArticleMatch struct {
+	ArticleNormalized string
+	// Exact - продукты с искомым артикулом
+	Exact []Product
+	// Analogs - продукты-аналоги по таблице кроссов
+	Analogs []Product
+}
+
+// CrossReference - взаимозаменяемость двух артикулов, пустой производитель означает любого
+type CrossReference struct {
+	Brand         string
+	Article       string
+	AnalogBrand   string
+	AnalogArticle string
+}
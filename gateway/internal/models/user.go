@@ -10,3 +10,10 @@ type User struct {
 	Role      string
 	IsBlocked bool
 }
+
+// UsersPage - страница списка пользователей
+type UsersPage struct {
+	Users []User
+	// NextCursor - курсор следующей страницы, пустой на последней странице
+	NextCursor string
+}
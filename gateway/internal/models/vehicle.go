@@ -0,0 +1,25 @@
+package models
+
+// Vehicle - автомобиль, для которого подбираются запчасти
+type Vehicle struct {
+	Make  string
+	Model string
+	// Year - год выпуска, 0 - любой
+	Year       int
+	EngineCode string
+	Body       string
+}
+
+// Fitment - применимость продукта к автомобилям одной модели
+type Fitment struct {
+	ID         string
+	Make       string
+	Model      string
+	Generation string
+	// YearFrom, YearTo - границы годов выпуска включительно, 0 - без ограничения
+	YearFrom int
+	YearTo   int
+	// EngineCode, Body - пустое значение означает любой двигатель или кузов
+	EngineCode string
+	Body       string
+}
@@ -19,10 +19,31 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProductServiceClient interface {
 	GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
 	GetProductByID(ctx context.Context, in *GetProductByIDRequest, opts ...grpc.CallOption) (*Product, error)
 	CreateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error)
 	UpdateProduct(ctx context.Context, in *Product, opts ...grpc.CallOption) (*Product, error)
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	// Применимость к автомобилям
+	ListMakes(ctx context.Context, in *ListMakesRequest, opts ...grpc.CallOption) (*ListMakesResponse, error)
+	ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error)
+	GetProductFitments(ctx context.Context, in *GetProductFitmentsRequest, opts ...grpc.CallOption) (*ProductFitments, error)
+	SetProductFitments(ctx context.Context, in *ProductFitments, opts ...grpc.CallOption) (*ProductFitments, error)
+	ListCompatibleProducts(ctx context.Context, in *ListCompatibleProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
+	// Поиск по артикулу и аналоги
+	FindByArticle(ctx context.Context, in *FindByArticleRequest, opts ...grpc.CallOption) (*FindByArticleResponse, error)
+	AddCrossReferences(ctx context.Context, in *AddCrossReferencesRequest, opts ...grpc.CallOption) (*AddCrossReferencesResponse, error)
+	// Предложения поставщиков
+	UpsertOffers(ctx context.Context, in *UpsertOffersRequest, opts ...grpc.CallOption) (*UpsertOffersResponse, error)
+	GetProductWithOffers(ctx context.Context, in *GetProductWithOffersRequest, opts ...grpc.CallOption) (*ProductWithOffers, error)
+	// Импорт прайс-листов поставщиков: файл передается потоком, обработка идет в фоне
+	ImportProducts(ctx context.Context, opts ...grpc.CallOption) (ProductService_ImportProductsClient, error)
+	GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	// Резервирование остатков предложений под заказ
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*Reservation, error)
+	ConfirmReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Reservation, error)
+	ReleaseReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Reservation, error)
+	GetReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Reservation, error)
 }
 
 type productServiceClient struct {
@@ -42,6 +63,15 @@ func (c *productServiceClient) GetProducts(ctx context.Context, in *GetProductsR
 	return out, nil
 }
 
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error) {
+	out := new(GetProductsResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/SearchProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetProductByID(ctx context.Context, in *GetProductByIDRequest, opts ...grpc.CallOption) (*Product, error) {
 	out := new(Product)
 	err := c.cc.Invoke(ctx, "/proto.ProductService/GetProductByID", in, out, opts...)
@@ -78,15 +108,196 @@ func (c *productServiceClient) DeleteProduct(ctx context.Context, in *DeleteProd
 	return out, nil
 }
 
+func (c *productServiceClient) ListMakes(ctx context.Context, in *ListMakesRequest, opts ...grpc.CallOption) (*ListMakesResponse, error) {
+	out := new(ListMakesResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/ListMakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error) {
+	out := new(ListModelsResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/ListModels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductFitments(ctx context.Context, in *GetProductFitmentsRequest, opts ...grpc.CallOption) (*ProductFitments, error) {
+	out := new(ProductFitments)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/GetProductFitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductFitments(ctx context.Context, in *ProductFitments, opts ...grpc.CallOption) (*ProductFitments, error) {
+	out := new(ProductFitments)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/SetProductFitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCompatibleProducts(ctx context.Context, in *ListCompatibleProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error) {
+	out := new(GetProductsResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/ListCompatibleProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) FindByArticle(ctx context.Context, in *FindByArticleRequest, opts ...grpc.CallOption) (*FindByArticleResponse, error) {
+	out := new(FindByArticleResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/FindByArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AddCrossReferences(ctx context.Context, in *AddCrossReferencesRequest, opts ...grpc.CallOption) (*AddCrossReferencesResponse, error) {
+	out := new(AddCrossReferencesResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/AddCrossReferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpsertOffers(ctx context.Context, in *UpsertOffersRequest, opts ...grpc.CallOption) (*UpsertOffersResponse, error) {
+	out := new(UpsertOffersResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/UpsertOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductWithOffers(ctx context.Context, in *GetProductWithOffersRequest, opts ...grpc.CallOption) (*ProductWithOffers, error) {
+	out := new(ProductWithOffers)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/GetProductWithOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportProducts(ctx context.Context, opts ...grpc.CallOption) (ProductService_ImportProductsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], "/proto.ProductService/ImportProducts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &productServiceImportProductsClient{stream}
+	return x, nil
+}
+
+type ProductService_ImportProductsClient interface {
+	Send(*ImportProductsRequest) error
+	CloseAndRecv() (*ImportJob, error)
+	grpc.ClientStream
+}
+
+type productServiceImportProductsClient struct {
+	grpc.ClientStream
+}
+
+func (x *productServiceImportProductsClient) Send(m *ImportProductsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *productServiceImportProductsClient) CloseAndRecv() (*ImportJob, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportJob)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *productServiceClient) GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/GetImportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/ReserveStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ConfirmReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/ConfirmReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReleaseReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/ReleaseReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/proto.ProductService/GetReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility
 type ProductServiceServer interface {
 	GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*GetProductsResponse, error)
 	GetProductByID(context.Context, *GetProductByIDRequest) (*Product, error)
 	CreateProduct(context.Context, *Product) (*Product, error)
 	UpdateProduct(context.Context, *Product) (*Product, error)
 	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	// Применимость к автомобилям
+	ListMakes(context.Context, *ListMakesRequest) (*ListMakesResponse, error)
+	ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error)
+	GetProductFitments(context.Context, *GetProductFitmentsRequest) (*ProductFitments, error)
+	SetProductFitments(context.Context, *ProductFitments) (*ProductFitments, error)
+	ListCompatibleProducts(context.Context, *ListCompatibleProductsRequest) (*GetProductsResponse, error)
+	// Поиск по артикулу и аналоги
+	FindByArticle(context.Context, *FindByArticleRequest) (*FindByArticleResponse, error)
+	AddCrossReferences(context.Context, *AddCrossReferencesRequest) (*AddCrossReferencesResponse, error)
+	// Предложения поставщиков
+	UpsertOffers(context.Context, *UpsertOffersRequest) (*UpsertOffersResponse, error)
+	GetProductWithOffers(context.Context, *GetProductWithOffersRequest) (*ProductWithOffers, error)
+	// Импорт прайс-листов поставщиков: файл передается потоком, обработка идет в фоне
+	ImportProducts(ProductService_ImportProductsServer) error
+	GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error)
+	// Резервирование остатков предложений под заказ
+	ReserveStock(context.Context, *ReserveStockRequest) (*Reservation, error)
+	ConfirmReservation(context.Context, *ReservationRequest) (*Reservation, error)
+	ReleaseReservation(context.Context, *ReservationRequest) (*Reservation, error)
+	GetReservation(context.Context, *ReservationRequest) (*Reservation, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -97,6 +308,9 @@ type UnimplementedProductServiceServer struct {
 func (UnimplementedProductServiceServer) GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProducts not implemented")
 }
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*GetProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
 func (UnimplementedProductServiceServer) GetProductByID(context.Context, *GetProductByIDRequest) (*Product, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductByID not implemented")
 }
@@ -109,6 +323,51 @@ func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *Product
 func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
 }
+func (UnimplementedProductServiceServer) ListMakes(context.Context, *ListMakesRequest) (*ListMakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMakes not implemented")
+}
+func (UnimplementedProductServiceServer) ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModels not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductFitments(context.Context, *GetProductFitmentsRequest) (*ProductFitments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductFitments not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductFitments(context.Context, *ProductFitments) (*ProductFitments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductFitments not implemented")
+}
+func (UnimplementedProductServiceServer) ListCompatibleProducts(context.Context, *ListCompatibleProductsRequest) (*GetProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompatibleProducts not implemented")
+}
+func (UnimplementedProductServiceServer) FindByArticle(context.Context, *FindByArticleRequest) (*FindByArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByArticle not implemented")
+}
+func (UnimplementedProductServiceServer) AddCrossReferences(context.Context, *AddCrossReferencesRequest) (*AddCrossReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCrossReferences not implemented")
+}
+func (UnimplementedProductServiceServer) UpsertOffers(context.Context, *UpsertOffersRequest) (*UpsertOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertOffers not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductWithOffers(context.Context, *GetProductWithOffersRequest) (*ProductWithOffers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductWithOffers not implemented")
+}
+func (UnimplementedProductServiceServer) ImportProducts(ProductService_ImportProductsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedProductServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedProductServiceServer) ConfirmReservation(context.Context, *ReservationRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReservation not implemented")
+}
+func (UnimplementedProductServiceServer) ReleaseReservation(context.Context, *ReservationRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (UnimplementedProductServiceServer) GetReservation(context.Context, *ReservationRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservation not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 
 // UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -140,6 +399,24 @@ func _ProductService_GetProducts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/SearchProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_GetProductByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductByIDRequest)
 	if err := dec(in); err != nil {
@@ -212,6 +489,284 @@ func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_ListMakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListMakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/ListMakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListMakes(ctx, req.(*ListMakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/ListModels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListModels(ctx, req.(*ListModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductFitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductFitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductFitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/GetProductFitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductFitments(ctx, req.(*GetProductFitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductFitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductFitments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductFitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/SetProductFitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductFitments(ctx, req.(*ProductFitments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCompatibleProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompatibleProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCompatibleProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/ListCompatibleProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCompatibleProducts(ctx, req.(*ListCompatibleProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_FindByArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).FindByArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/FindByArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).FindByArticle(ctx, req.(*FindByArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddCrossReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCrossReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddCrossReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/AddCrossReferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddCrossReferences(ctx, req.(*AddCrossReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpsertOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpsertOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/UpsertOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpsertOffers(ctx, req.(*UpsertOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductWithOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductWithOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductWithOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/GetProductWithOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductWithOffers(ctx, req.(*GetProductWithOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServiceServer).ImportProducts(&productServiceImportProductsServer{stream})
+}
+
+type ProductService_ImportProductsServer interface {
+	SendAndClose(*ImportJob) error
+	Recv() (*ImportProductsRequest, error)
+	grpc.ServerStream
+}
+
+type productServiceImportProductsServer struct {
+	grpc.ServerStream
+}
+
+func (x *productServiceImportProductsServer) SendAndClose(m *ImportJob) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *productServiceImportProductsServer) Recv() (*ImportProductsRequest, error) {
+	m := new(ImportProductsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ProductService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/GetImportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetImportJob(ctx, req.(*GetImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/ReserveStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ConfirmReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/ConfirmReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ConfirmReservation(ctx, req.(*ReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/ReleaseReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReleaseReservation(ctx, req.(*ReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProductService/GetReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetReservation(ctx, req.(*ReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -223,6 +778,10 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProducts",
 			Handler:    _ProductService_GetProducts_Handler,
 		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
 		{
 			MethodName: "GetProductByID",
 			Handler:    _ProductService_GetProductByID_Handler,
@@ -239,7 +798,69 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProduct",
 			Handler:    _ProductService_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "ListMakes",
+			Handler:    _ProductService_ListMakes_Handler,
+		},
+		{
+			MethodName: "ListModels",
+			Handler:    _ProductService_ListModels_Handler,
+		},
+		{
+			MethodName: "GetProductFitments",
+			Handler:    _ProductService_GetProductFitments_Handler,
+		},
+		{
+			MethodName: "SetProductFitments",
+			Handler:    _ProductService_SetProductFitments_Handler,
+		},
+		{
+			MethodName: "ListCompatibleProducts",
+			Handler:    _ProductService_ListCompatibleProducts_Handler,
+		},
+		{
+			MethodName: "FindByArticle",
+			Handler:    _ProductService_FindByArticle_Handler,
+		},
+		{
+			MethodName: "AddCrossReferences",
+			Handler:    _ProductService_AddCrossReferences_Handler,
+		},
+		{
+			MethodName: "UpsertOffers",
+			Handler:    _ProductService_UpsertOffers_Handler,
+		},
+		{
+			MethodName: "GetProductWithOffers",
+			Handler:    _ProductService_GetProductWithOffers_Handler,
+		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _ProductService_GetImportJob_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _ProductService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ConfirmReservation",
+			Handler:    _ProductService_ConfirmReservation_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _ProductService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "GetReservation",
+			Handler:    _ProductService_GetReservation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProducts",
+			Handler:       _ProductService_ImportProducts_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/products.proto",
 }
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"gateway/internal/models"
 	"gateway/internal/proto"
@@ -11,7 +12,11 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// ErrInvalidToken - токен не прошел проверку в сервисе пользователей
+var ErrInvalidToken = errors.New("невалидный токен")
+
 type AuthService struct {
+	conn   *grpc.ClientConn
 	client proto.UserServiceClient
 	logger *zap.Logger
 }
@@ -27,7 +32,12 @@ func NewAuthService(grpcAddress string, logger *zap.Logger) (*AuthService, error
 	client := proto.NewUserServiceClient(conn)
 	logger.Info("gRPC клиент успешно подключен", zap.String("address", grpcAddress))
 
-	return &AuthService{client: client, logger: logger}, nil
+	return &AuthService{conn: conn, client: client, logger: logger}, nil
+}
+
+// Close - закрытие gRPC соединения
+func (s *AuthService) Close() error {
+	return s.conn.Close()
 }
 
 func (s *AuthService) Login(ctx context.Context, credentials *models.LoginCredentials) (*models.AuthCredentials, error) {
@@ -66,7 +76,8 @@ func (s *AuthService) Refresh(ctx context.Context, authCredentials *models.AuthC
 	}, nil
 }
 
-func (s *AuthService) Validate(ctx context.Context, access string) (bool, error) {
+// Validate - проверка access-токена, возвращает данные пользователя из токена
+func (s *AuthService) Validate(ctx context.Context, access string) (*models.TokenClaims, error) {
 	s.logger.Info("Валидация сессионного токена")
 
 	resp, err := s.client.ValidateToken(ctx, &proto.ValidateTokenRequest{
@@ -74,8 +85,16 @@ func (s *AuthService) Validate(ctx context.Context, access string) (bool, error)
 	})
 	if err != nil {
 		s.logger.Error("Ошибка валидации сессионного токена", zap.Error(err))
-		return false, err
+		return nil, err
 	}
-	s.logger.Info("Валидация сессионного токена прошла успешно")
-	return resp.GetValid(), nil
+	if !resp.GetValid() {
+		s.logger.Info("Сессионный токен невалиден")
+		return nil, ErrInvalidToken
+	}
+
+	s.logger.Info("Валидация сессионного токена прошла успешно", zap.String("user_id", resp.GetUserId()), zap.String("role", resp.GetRole()))
+	return &models.TokenClaims{
+		UserID: resp.GetUserId(),
+		Role:   resp.GetRole(),
+	}, nil
 }
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"gateway/internal/models"
+	"gateway/internal/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// CartService - gRPC клиент корзин сервиса заказов
+type CartService struct {
+	conn   *grpc.ClientConn
+	client proto.CartServiceClient
+	logger *zap.Logger
+}
+
+// NewCartService - конструктор клиента корзин
+func NewCartService(grpcAddress string, logger *zap.Logger) (*CartService, error) {
+	conn, err := grpc.NewClient(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error("Ошибка подключения к gRPC серверу", zap.String("address", grpcAddress), zap.Error(err))
+		return nil, fmt.Errorf("не удалось подключиться к gRPC серверу: %w", err)
+	}
+
+	logger.Info("gRPC клиент успешно подключен", zap.String("address", grpcAddress))
+	return &CartService{conn: conn, client: proto.NewCartServiceClient(conn), logger: logger}, nil
+}
+
+// Close - закрытие gRPC соединения
+func (c *CartService) Close() error {
+	return c.conn.Close()
+}
+
+// Get - корзина по текущим ценам каталога
+func (c *CartService) Get(ctx context.Context, owner models.CartOwner) (models.Cart, error) {
+	resp, err := c.client.GetCart(ctx, &proto.GetCartRequest{Owner: toProtoCartOwner(owner)})
+	if err != nil {
+		return models.Cart{}, err
+	}
+	return fromProtoCart(resp), nil
+}
+
+// Add - добавление товара в корзину
+func (c *CartService) Add(ctx context.Context, owner models.CartOwner, item models.CartItem) (models.Cart, error) {
+	c.logger.Info("Добавление в корзину", zap.String("product_id", item.ProductID), zap.Int("quantity", item.Quantity))
+
+	resp, err := c.client.AddCartItem(ctx, &proto.AddCartItemRequest{
+		Owner:     toProtoCartOwner(owner),
+		ProductId: item.ProductID,
+		OfferId:   item.OfferID,
+		Quantity:  int32(item.Quantity),
+	})
+	if err != nil {
+		c.logger.Error("Ошибка добавления в корзину", zap.String("product_id", item.ProductID), zap.Error(err))
+		return models.Cart{}, err
+	}
+	return fromProtoCart(resp), nil
+}
+
+// Update - изменение количества позиции корзины
+func (c *CartService) Update(ctx context.Context, owner models.CartOwner, item models.CartItem) (models.Cart, error) {
+	resp, err := c.client.UpdateCartItem(ctx, &proto.UpdateCartItemRequest{
+		Owner:     toProtoCartOwner(owner),
+		ProductId: item.ProductID,
+		OfferId:   item.OfferID,
+		Quantity:  int32(item.Quantity),
+	})
+	if err != nil {
+		c.logger.Error("Ошибка изменения позиции корзины", zap.String("product_id", item.ProductID), zap.Error(err))
+		return models.Cart{}, err
+	}
+	return fromProtoCart(resp), nil
+}
+
+// Remove - удаление позиции из корзины
+func (c *CartService) Remove(ctx context.Context, owner models.CartOwner, productID, offerID string) (models.Cart, error) {
+	resp, err := c.client.RemoveCartItem(ctx, &proto.RemoveCartItemRequest{
+		Owner:     toProtoCartOwner(owner),
+		ProductId: productID,
+		OfferId:   offerID,
+	})
+	if err != nil {
+		c.logger.Error("Ошибка удаления позиции корзины", zap.String("product_id", productID), zap.Error(err))
+		return models.Cart{}, err
+	}
+	return fromProtoCart(resp), nil
+}
+
+// Clear - очистка корзины
+func (c *CartService) Clear(ctx context.Context, owner models.CartOwner) (models.Cart, error) {
+	resp, err := c.client.ClearCart(ctx, &proto.ClearCartRequest{Owner: toProtoCartOwner(owner)})
+	if err != nil {
+		c.logger.Error("Ошибка очистки корзины", zap.Error(err))
+		return models.Cart{}, err
+	}
+	return fromProtoCart(resp), nil
+}
+
+// Merge - перенос гостевой корзины в корзину пользователя
+func (c *CartService) Merge(ctx context.Context, requester *models.TokenClaims, guestID string) (models.Cart, error) {
+	resp, err := c.client.MergeCarts(ctx, &proto.MergeCartsRequest{
+		Requester: toProtoRequester(requester),
+		GuestId:   guestID,
+	})
+	if err != nil {
+		c.logger.Error("Ошибка переноса гостевой корзины", zap.Error(err))
+		return models.Cart{}, err
+	}
+	return fromProtoCart(resp), nil
+}
+
+// Checkout - оформление заказа из корзины пользователя
+func (c *CartService) Checkout(ctx context.Context, requester *models.TokenClaims, expectedTotal float64) (models.Order, error) {
+	c.logger.Info("Оформление заказа из корзины", zap.String("user_id", requester.UserID))
+
+	resp, err := c.client.Checkout(ctx, &proto.CheckoutRequest{
+		Requester:     toProtoRequester(requester),
+		ExpectedTotal: expectedTotal,
+	})
+	if err != nil {
+		c.logger.Error("Ошибка оформления заказа из корзины", zap.String("user_id", requester.UserID), zap.Error(err))
+		return models.Order{}, err
+	}
+
+	order := fromProtoOrder(resp.GetOrder())
+	c.logger.Info("Заказ оформлен из корзины", zap.String("id", order.ID))
+	return order, nil
+}
+
+// toProtoCartOwner - владелец корзины для сервиса заказов
+func toProtoCartOwner(owner models.CartOwner) *proto.CartOwner {
+	return &proto.CartOwner{
+		Requester: toProtoRequester(owner.Claims),
+		GuestId:   owner.GuestID,
+	}
+}
+
+// fromProtoCart - преобразование корзины из ответа сервиса заказов
+func fromProtoCart(c *proto.Cart) models.Cart {
+	cart := models.Cart{
+		UserID:   c.GetUserId(),
+		GuestID:  c.GetGuestId(),
+		Subtotal: c.GetSubtotal(),
+		Currency: c.GetCurrency(),
+		Ready:    c.GetReady(),
+	}
+	if c.GetUpdatedAt() != nil {
+		cart.UpdatedAt = c.GetUpdatedAt().AsTime()
+	}
+	for _, item := range c.GetItems() {
+		cart.Items = append(cart.Items, models.CartItem{
+			ProductID:      item.GetProductId(),
+			OfferID:        item.GetOfferId(),
+			Quantity:       int(item.GetQuantity()),
+			Name:           item.GetName(),
+			Supplier:       item.GetSupplier(),
+			UnitPrice:      item.GetUnitPrice(),
+			AddedUnitPrice: item.GetAddedUnitPrice(),
+			LineTotal:      item.GetLineTotal(),
+			Problem:        item.GetProblem(),
+			AddedAt:        item.GetAddedAt().AsTime(),
+		})
+	}
+	return cart
+}
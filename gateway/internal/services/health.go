@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkHealth - запрос общего статуса сервера по стандартному протоколу grpc.health.v1
+func checkHealth(ctx context.Context, conn *grpc.ClientConn) error {
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("сервис в статусе %s", resp.GetStatus())
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 
 // OrdersService - gRPC клиент
 type OrdersService struct {
+	conn   *grpc.ClientConn
 	client proto.OrderServiceClient
 	logger *zap.Logger
 }
@@ -28,112 +29,137 @@ func NewOrdersService(grpcAddress string, logger *zap.Logger) (*OrdersService, e
 	client := proto.NewOrderServiceClient(conn)
 	logger.Info("gRPC клиент успешно подключен", zap.String("address", grpcAddress))
 
-	return &OrdersService{client: client, logger: logger}, nil
+	return &OrdersService{conn: conn, client: client, logger: logger}, nil
 }
 
-// Get - получение списка заказов
-func (o *OrdersService) Get(ctx context.Context, offset int, limit int, userID string) ([]models.Order, error) {
-	o.logger.Info("Запрос списка заказов", zap.Int("page", offset), zap.Int("limit", limit))
+// Close - закрытие gRPC соединения
+func (o *OrdersService) Close() error {
+	return o.conn.Close()
+}
+
+// CheckHealth - проверка состояния сервиса через grpc.health.v1
+func (o *OrdersService) CheckHealth(ctx context.Context) error {
+	return checkHealth(ctx, o.conn)
+}
+
+// Get - получение страницы заказов, доступных пользователю, по смещению или курсору
+func (o *OrdersService) Get(ctx context.Context, offset int, limit int, cursor string, userID string, requester *models.TokenClaims) (models.OrdersPage, error) {
+	o.logger.Info("Запрос списка заказов", zap.Int("offset", offset), zap.Int("limit", limit))
 
 	resp, err := o.client.ListOrders(ctx, &proto.ListOrdersRequest{
-		UserId: userID,
-		Offset: int32(offset),
-		Limit:  int32(limit),
+		UserId:    userID,
+		Offset:    int32(offset),
+		Limit:     int32(limit),
+		Requester: toProtoRequester(requester),
+		Cursor:    cursor,
 	})
 	if err != nil {
 		o.logger.Error("Ошибка получения списка заказов", zap.Error(err))
-		return nil, err
+		return models.OrdersPage{}, err
 	}
 
 	var orders []models.Order
 	for _, ord := range resp.Orders {
-		orders = append(orders, models.Order{
-			ID:          ord.Id,
-			UserID:      ord.UserId,
-			ProductsIDs: ord.ProductIds,
-			Status:      ord.Status,
-			Total:       uint(ord.TotalPrice),
-			CreatedAt:   ord.GetCreatedAt().AsTime(),
-		})
+		orders = append(orders, fromProtoOrder(ord))
 	}
 
 	o.logger.Info("Список заказов успешно получен", zap.Int("count", len(orders)))
-	return orders, nil
+	return models.OrdersPage{Orders: orders, NextCursor: resp.GetNextCursor()}, nil
 }
 
 // GetByID - получение заказа по ID
-func (o *OrdersService) GetByID(ctx context.Context, id string) (models.Order, error) {
+func (o *OrdersService) GetByID(ctx context.Context, id string, requester *models.TokenClaims) (models.Order, error) {
 	o.logger.Info("Запрос заказа по ID", zap.String("id", id))
 
 	resp, err := o.client.GetOrder(ctx, &proto.GetOrderRequest{
-		OrderId: id,
+		OrderId:   id,
+		Requester: toProtoRequester(requester),
 	})
 	if err != nil {
 		return models.Order{}, err
 	}
 
-	order := models.Order{
-		ID:          resp.Order.Id,
-		UserID:      resp.Order.UserId,
-		ProductsIDs: resp.Order.ProductIds,
-		Status:      resp.Order.Status,
-		Total:       uint(resp.Order.TotalPrice),
-	}
+	order := fromProtoOrder(resp.GetOrder())
 
 	o.logger.Info("Заказ успешно получен", zap.String("id", order.ID))
 	return order, nil
 }
 
-// Create - создание нового заказа
-func (o *OrdersService) Create(order models.Order) (models.Order, error) {
-	o.logger.Info("Создание нового заказа", zap.String("user_id", order.UserID))
+// Create - создание нового заказа. Цены позиций и сумму рассчитывает сервис заказов.
+func (o *OrdersService) Create(order models.Order, requester *models.TokenClaims) (models.Order, error) {
+	o.logger.Info("Создание нового заказа", zap.String("user_id", order.UserID), zap.Int("items", len(order.Items)))
 
-	resp, err := o.client.CreateOrder(context.Background(), &proto.CreateOrderRequest{
-		UserId:     order.UserID,
-		ProductIds: order.ProductsIDs,
-		Total:      int32(order.Total),
-	})
+	req := &proto.CreateOrderRequest{UserId: order.UserID, Requester: toProtoRequester(requester)}
+	for _, item := range order.Items {
+		req.Items = append(req.Items, &proto.CreateOrderItem{
+			ProductId: item.ProductID,
+			OfferId:   item.OfferID,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+
+	resp, err := o.client.CreateOrder(context.Background(), req)
 	if err != nil {
 		o.logger.Error("Ошибка создания заказа", zap.String("user_id", order.UserID), zap.Error(err))
 		return models.Order{}, err
 	}
 
-	createdOrder := models.Order{
-		ID:          resp.GetOrder().GetId(),
-		UserID:      resp.GetOrder().GetUserId(),
-		ProductsIDs: resp.GetOrder().GetProductIds(),
-		Status:      resp.GetOrder().GetStatus(),
-		Total:       uint(resp.GetOrder().GetTotalPrice()),
-		CreatedAt:   resp.GetOrder().GetCreatedAt().AsTime(),
-	}
+	createdOrder := fromProtoOrder(resp.GetOrder())
 
 	o.logger.Info("Заказ успешно создан", zap.String("id", createdOrder.ID))
 	return createdOrder, nil
 }
 
-// Update - обновление заказа
-func (o *OrdersService) Update(order models.Order) (models.Order, error) {
-	o.logger.Info("Обновление заказа", zap.String("id", order.ID))
+// UpdateStatus - перевод заказа в новый статус
+func (o *OrdersService) UpdateStatus(id, status, comment string, requester *models.TokenClaims) (models.Order, error) {
+	o.logger.Info("Обновление статуса заказа", zap.String("id", id), zap.String("status", status))
 
-	_, err := o.client.UpdateOrderStatus(context.Background(), &proto.UpdateOrderStatusRequest{
-		OrderId: order.ID,
-		Status:  order.Status,
+	resp, err := o.client.UpdateOrderStatus(context.Background(), &proto.UpdateOrderStatusRequest{
+		OrderId:   id,
+		Status:    status,
+		Comment:   comment,
+		Requester: toProtoRequester(requester),
 	})
 	if err != nil {
-		o.logger.Error("Ошибка обновления заказа", zap.String("id", order.ID), zap.Error(err))
+		o.logger.Error("Ошибка обновления статуса заказа", zap.String("id", id), zap.Error(err))
 		return models.Order{}, err
 	}
 
-	o.logger.Info("Заказ успешно обновлен", zap.String("id", order.ID))
-	return order, nil
+	o.logger.Info("Статус заказа успешно обновлен", zap.String("id", id), zap.String("status", status))
+	return fromProtoOrder(resp.GetOrder()), nil
+}
+
+// History - история статусов заказа
+func (o *OrdersService) History(ctx context.Context, id string, requester *models.TokenClaims) ([]models.StatusChange, error) {
+	resp, err := o.client.GetOrderHistory(ctx, &proto.GetOrderHistoryRequest{
+		OrderId:   id,
+		Requester: toProtoRequester(requester),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]models.StatusChange, 0, len(resp.GetHistory()))
+	for _, change := range resp.GetHistory() {
+		history = append(history, models.StatusChange{
+			From:      change.GetFrom(),
+			To:        change.GetTo(),
+			ActorID:   change.GetActorId(),
+			ActorRole: change.GetActorRole(),
+			Comment:   change.GetComment(),
+			ChangedAt: change.GetChangedAt().AsTime(),
+		})
+	}
+	return history, nil
 }
 
 // Delete - удаление заказа
-func (o *OrdersService) Delete(id string) error {
+func (o *OrdersService) Delete(id string, requester *models.TokenClaims) error {
 	o.logger.Info("Удаление заказа", zap.String("id", id))
 
 	_, err := o.client.DeleteOrder(context.Background(), &proto.DeleteOrderRequest{
-		OrderId: id,
+		OrderId:   id,
+		Requester: toProtoRequester(requester),
 	})
 
 	if err != nil {
@@ -144,3 +170,45 @@ func (o *OrdersService) Delete(id string) error {
 	o.logger.Info("Заказ успешно удален", zap.String("id", id))
 	return nil
 }
+
+// fromProtoOrder - преобразование заказа из ответа сервиса заказов
+func fromProtoOrder(ord *proto.Order) models.Order {
+	order := models.Order{
+		ID:        ord.GetId(),
+		UserID:    ord.GetUserId(),
+		Status:    ord.GetStatus(),
+		Subtotal:  ord.GetSubtotal(),
+		Total:     ord.GetTotalPrice(),
+		Currency:  ord.GetCurrency(),
+		CreatedAt: ord.GetCreatedAt().AsTime(),
+	}
+	if ord.GetUpdatedAt() != nil {
+		order.UpdatedAt = ord.GetUpdatedAt().AsTime()
+	}
+	if ord.GetReservedUntil() != nil {
+		order.ReservedUntil = ord.GetReservedUntil().AsTime()
+	}
+	for _, item := range ord.GetItems() {
+		order.Items = append(order.Items, models.OrderItem{
+			ProductID: item.GetProductId(),
+			OfferID:   item.GetOfferId(),
+			Supplier:  item.GetSupplier(),
+			Name:      item.GetName(),
+			Quantity:  int(item.GetQuantity()),
+			UnitPrice: item.GetUnitPrice(),
+			LineTotal: item.GetLineTotal(),
+		})
+	}
+	return order
+}
+
+// toProtoRequester - передача данных авторизованного пользователя в сервис заказов
+func toProtoRequester(claims *models.TokenClaims) *proto.Requester {
+	if claims == nil {
+		return nil
+	}
+	return &proto.Requester{
+		UserId: claims.UserID,
+		Role:   claims.Role,
+	}
+}
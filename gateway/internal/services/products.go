@@ -2,18 +2,24 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"gateway/internal/models"
 	"gateway/internal/proto"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // ProductsService - gRPC клиент
 type ProductsService struct {
+	conn   *grpc.ClientConn
 	client proto.ProductServiceClient
 	logger *zap.Logger
 }
@@ -29,34 +35,90 @@ func NewProductsService(grpcAddress string, logger *zap.Logger) (*ProductsServic
 	client := proto.NewProductServiceClient(conn)
 	logger.Info("gRPC клиент успешно подключен", zap.String("address", grpcAddress))
 
-	return &ProductsService{client: client, logger: logger}, nil
+	return &ProductsService{conn: conn, client: client, logger: logger}, nil
 }
 
-// Get - получение списка продуктов с логированием
-func (p *ProductsService) Get(ctx context.Context, page int, limit int) ([]models.Product, error) {
-	p.logger.Info("Запрос списка продуктов", zap.Int("page", page), zap.Int("limit", limit))
+// Close - закрытие gRPC соединения
+func (p *ProductsService) Close() error {
+	return p.conn.Close()
+}
+
+// CheckHealth - проверка состояния сервиса через grpc.health.v1
+func (p *ProductsService) CheckHealth(ctx context.Context) error {
+	return checkHealth(ctx, p.conn)
+}
+
+// Get - получение страницы продуктов с логированием
+func (p *ProductsService) Get(ctx context.Context, offset int, limit int) (models.ProductsPage, error) {
+	p.logger.Info("Запрос списка продуктов", zap.Int("offset", offset), zap.Int("limit", limit))
 
 	resp, err := p.client.GetProducts(ctx, &proto.GetProductsRequest{
-		Page:  int32(page),
-		Limit: int32(limit),
+		Offset: int32(offset),
+		Limit:  int32(limit),
 	})
 	if err != nil {
 		p.logger.Error("Ошибка получения списка продуктов", zap.Error(err))
-		return nil, err
+		return models.ProductsPage{}, err
 	}
 
 	var products []models.Product
 	for _, p := range resp.Products {
-		products = append(products, models.Product{
-			ID:          p.Id,
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       p.Price,
-		})
+		products = append(products, productFromProto(p))
+	}
+
+	p.logger.Info("Список продуктов успешно получен", zap.Int("count", len(products)), zap.Int64("total", resp.GetTotal()))
+	return models.ProductsPage{
+		Products: products,
+		Total:    resp.GetTotal(),
+		HasNext:  resp.GetNextPageToken() != "",
+	}, nil
+}
+
+// productSorts - соответствие порядка сортировки значениям gRPC
+var productSorts = map[string]proto.ProductSort{
+	"":           proto.ProductSort_PRODUCT_SORT_UNSPECIFIED,
+	"relevance":  proto.ProductSort_PRODUCT_SORT_RELEVANCE,
+	"price_asc":  proto.ProductSort_PRODUCT_SORT_PRICE_ASC,
+	"price_desc": proto.ProductSort_PRODUCT_SORT_PRICE_DESC,
+	"newest":     proto.ProductSort_PRODUCT_SORT_NEWEST,
+}
+
+// Search - поиск страницы продуктов по фильтру с логированием
+func (p *ProductsService) Search(ctx context.Context, filter models.ProductFilter, offset int, limit int) (models.ProductsPage, error) {
+	p.logger.Info("Поиск продуктов", zap.String("query", filter.Query), zap.String("category", filter.Category), zap.Int("offset", offset), zap.Int("limit", limit))
+
+	sort, ok := productSorts[filter.Sort]
+	if !ok {
+		return models.ProductsPage{}, status.Errorf(codes.InvalidArgument, "неизвестный порядок сортировки %q", filter.Sort)
+	}
+
+	resp, err := p.client.SearchProducts(ctx, &proto.SearchProductsRequest{
+		Query:      filter.Query,
+		Category:   filter.Category,
+		MinPrice:   filter.MinPrice,
+		MaxPrice:   filter.MaxPrice,
+		Attributes: filter.Attributes,
+		Vehicle:    vehicleToProto(filter.Vehicle),
+		Sort:       sort,
+		Offset:     int32(offset),
+		Limit:      int32(limit),
+	})
+	if err != nil {
+		p.logger.Error("Ошибка поиска продуктов", zap.Error(err))
+		return models.ProductsPage{}, err
+	}
+
+	var products []models.Product
+	for _, p := range resp.Products {
+		products = append(products, productFromProto(p))
 	}
 
-	p.logger.Info("Список продуктов успешно получен", zap.Int("count", len(products)))
-	return products, nil
+	p.logger.Info("Поиск продуктов выполнен", zap.Int("count", len(products)), zap.Int64("total", resp.GetTotal()))
+	return models.ProductsPage{
+		Products: products,
+		Total:    resp.GetTotal(),
+		HasNext:  resp.GetNextPageToken() != "",
+	}, nil
 }
 
 // GetByID - получение продукта по ID с логированием
@@ -68,12 +130,7 @@ func (p *ProductsService) GetByID(ctx context.Context, id string) (models.Produc
 		return models.Product{}, err
 	}
 
-	product := models.Product{
-		ID:          resp.Id,
-		Name:        resp.Name,
-		Description: resp.Description,
-		Price:       resp.Price,
-	}
+	product := productFromProto(resp)
 
 	p.logger.Info("Продукт успешно получен", zap.String("id", product.ID))
 	return product, nil
@@ -83,24 +140,19 @@ func (p *ProductsService) GetByID(ctx context.Context, id string) (models.Produc
 func (p *ProductsService) Create(product models.Product) (models.Product, error) {
 	p.logger.Info("Создание нового продукта", zap.String("name", product.Name))
 
-	resp, err := p.client.CreateProduct(context.Background(), &proto.Product{
-		Id:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       float32(product.Price),
-	})
+	req, err := productToProto(product)
 	if err != nil {
-		p.logger.Error("Ошибка создания продукта", zap.String("name", product.Name), zap.Error(err))
 		return models.Product{}, err
 	}
 
-	createdProduct := models.Product{
-		ID:          resp.Id,
-		Name:        resp.Name,
-		Description: resp.Description,
-		Price:       resp.Price,
+	resp, err := p.client.CreateProduct(context.Background(), req)
+	if err != nil {
+		p.logger.Error("Ошибка создания продукта", zap.String("name", product.Name), zap.Error(err))
+		return models.Product{}, err
 	}
 
+	createdProduct := productFromProto(resp)
+
 	p.logger.Info("Продукт успешно создан", zap.String("id", createdProduct.ID))
 	return createdProduct, nil
 }
@@ -109,24 +161,19 @@ func (p *ProductsService) Create(product models.Product) (models.Product, error)
 func (p *ProductsService) Put(product models.Product) (models.Product, error) {
 	p.logger.Info("Обновление продукта", zap.String("id", product.ID))
 
-	resp, err := p.client.UpdateProduct(context.Background(), &proto.Product{
-		Id:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-	})
+	req, err := productToProto(product)
 	if err != nil {
-		p.logger.Error("Ошибка обновления продукта", zap.String("id", product.ID), zap.Error(err))
 		return models.Product{}, err
 	}
 
-	updatedProduct := models.Product{
-		ID:          resp.Id,
-		Name:        resp.Name,
-		Description: resp.Description,
-		Price:       resp.Price,
+	resp, err := p.client.UpdateProduct(context.Background(), req)
+	if err != nil {
+		p.logger.Error("Ошибка обновления продукта", zap.String("id", product.ID), zap.Error(err))
+		return models.Product{}, err
 	}
 
+	updatedProduct := productFromProto(resp)
+
 	p.logger.Info("Продукт успешно обновлен", zap.String("id", updatedProduct.ID))
 	return updatedProduct, nil
 }
@@ -144,3 +191,398 @@ func (p *ProductsService) Delete(id string) error {
 	p.logger.Info("Продукт успешно удален", zap.String("id", id))
 	return nil
 }
+
+// ListMakes - получение списка марок, для которых есть запчасти
+func (p *ProductsService) ListMakes(ctx context.Context) ([]string, error) {
+	p.logger.Info("Запрос списка марок")
+
+	resp, err := p.client.ListMakes(ctx, &proto.ListMakesRequest{})
+	if err != nil {
+		p.logger.Error("Ошибка получения списка марок", zap.Error(err))
+		return nil, err
+	}
+	return resp.GetMakes(), nil
+}
+
+// ListModels - получение списка моделей марки, для которых есть запчасти
+func (p *ProductsService) ListModels(ctx context.Context, vehicleMake string) ([]string, error) {
+	p.logger.Info("Запрос списка моделей", zap.String("make", vehicleMake))
+
+	resp, err := p.client.ListModels(ctx, &proto.ListModelsRequest{Make: vehicleMake})
+	if err != nil {
+		p.logger.Error("Ошибка получения списка моделей", zap.String("make", vehicleMake), zap.Error(err))
+		return nil, err
+	}
+	return resp.GetModels(), nil
+}
+
+// GetFitments - получение применимости продукта
+func (p *ProductsService) GetFitments(ctx context.Context, productID string) ([]models.Fitment, error) {
+	p.logger.Info("Запрос применимости продукта", zap.String("product_id", productID))
+
+	resp, err := p.client.GetProductFitments(ctx, &proto.GetProductFitmentsRequest{ProductId: productID})
+	if err != nil {
+		p.logger.Error("Ошибка получения применимости продукта", zap.String("product_id", productID), zap.Error(err))
+		return nil, err
+	}
+	return fitmentsFromProto(resp), nil
+}
+
+// SetFitments - замена применимости продукта
+func (p *ProductsService) SetFitments(ctx context.Context, productID string, fitments []models.Fitment) ([]models.Fitment, error) {
+	p.logger.Info("Изменение применимости продукта", zap.String("product_id", productID), zap.Int("count", len(fitments)))
+
+	req := &proto.ProductFitments{ProductId: productID}
+	for _, f := range fitments {
+		req.Fitments = append(req.Fitments, &proto.Fitment{
+			Make:       f.Make,
+			Model:      f.Model,
+			Generation: f.Generation,
+			YearFrom:   int32(f.YearFrom),
+			YearTo:     int32(f.YearTo),
+			EngineCode: f.EngineCode,
+			Body:       f.Body,
+		})
+	}
+
+	resp, err := p.client.SetProductFitments(ctx, req)
+	if err != nil {
+		p.logger.Error("Ошибка изменения применимости продукта", zap.String("product_id", productID), zap.Error(err))
+		return nil, err
+	}
+
+	p.logger.Info("Применимость продукта успешно изменена", zap.String("product_id", productID))
+	return fitmentsFromProto(resp), nil
+}
+
+// FindByArticle - поиск продукта по артикулу и его аналогов
+func (p *ProductsService) FindByArticle(ctx context.Context, article, brand string) (models.ArticleMatch, error) {
+	p.logger.Info("Поиск по артикулу", zap.String("article", article), zap.String("brand", brand))
+
+	resp, err := p.client.FindByArticle(ctx, &proto.FindByArticleRequest{Article: article, Brand: brand})
+	if err != nil {
+		p.logger.Error("Ошибка поиска по артикулу", zap.String("article", article), zap.Error(err))
+		return models.ArticleMatch{}, err
+	}
+
+	match := models.ArticleMatch{ArticleNormalized: resp.GetArticleNormalized()}
+	for _, product := range resp.GetExact() {
+		match.Exact = append(match.Exact, productFromProto(product))
+	}
+	for _, product := range resp.GetAnalogs() {
+		match.Analogs = append(match.Analogs, productFromProto(product))
+	}
+	return match, nil
+}
+
+// AddCrossReferences - добавление кроссов, возвращает количество новых записей
+func (p *ProductsService) AddCrossReferences(ctx context.Context, refs []models.CrossReference) (int64, error) {
+	p.logger.Info("Добавление кроссов", zap.Int("count", len(refs)))
+
+	req := &proto.AddCrossReferencesRequest{}
+	for _, ref := range refs {
+		req.CrossReferences = append(req.CrossReferences, &proto.CrossReference{
+			Brand:         ref.Brand,
+			Article:       ref.Article,
+			AnalogBrand:   ref.AnalogBrand,
+			AnalogArticle: ref.AnalogArticle,
+		})
+	}
+
+	resp, err := p.client.AddCrossReferences(ctx, req)
+	if err != nil {
+		p.logger.Error("Ошибка добавления кроссов", zap.Error(err))
+		return 0, err
+	}
+
+	p.logger.Info("Кроссы добавлены", zap.Int64("added", resp.GetAdded()))
+	return resp.GetAdded(), nil
+}
+
+// offerSorts - соответствие параметра сортировки предложений значению gRPC-перечисления
+var offerSorts = map[string]proto.OfferSort{
+	"":         proto.OfferSort_OFFER_SORT_UNSPECIFIED,
+	"price":    proto.OfferSort_OFFER_SORT_PRICE,
+	"delivery": proto.OfferSort_OFFER_SORT_DELIVERY,
+}
+
+// UpsertOffers - загрузка предложений поставщиков, возвращает количество новых и измененных предложений
+func (p *ProductsService) UpsertOffers(ctx context.Context, offers []models.Offer) (inserted, updated int64, err error) {
+	p.logger.Info("Загрузка предложений", zap.Int("count", len(offers)))
+
+	req := &proto.UpsertOffersRequest{}
+	for _, offer := range offers {
+		req.Offers = append(req.Offers, &proto.Offer{
+			ProductId:    offer.ProductID,
+			Supplier:     offer.Supplier,
+			Price:        offer.Price,
+			Currency:     offer.Currency,
+			Quantity:     int32(offer.Quantity),
+			DeliveryDays: int32(offer.DeliveryDays),
+			Url:          offer.URL,
+			Title:        offer.Title,
+			Condition:    offer.Condition,
+			ImageUrl:     offer.ImageURL,
+			Seller:       offer.Seller,
+			Location:     offer.Location,
+		})
+	}
+
+	resp, err := p.client.UpsertOffers(ctx, req)
+	if err != nil {
+		p.logger.Error("Ошибка загрузки предложений", zap.Error(err))
+		return 0, 0, err
+	}
+
+	p.logger.Info("Предложения загружены", zap.Int64("inserted", resp.GetInserted()), zap.Int64("updated", resp.GetUpdated()))
+	return resp.GetInserted(), resp.GetUpdated(), nil
+}
+
+// GetWithOffers - получение продукта со всеми предложениями, sort - price или delivery
+func (p *ProductsService) GetWithOffers(ctx context.Context, productID, sort string) (models.ProductWithOffers, error) {
+	p.logger.Info("Запрос предложений по продукту", zap.String("product_id", productID), zap.String("sort", sort))
+
+	offerSort, ok := offerSorts[sort]
+	if !ok {
+		return models.ProductWithOffers{}, status.Errorf(codes.InvalidArgument, "неизвестный порядок сортировки предложений %q", sort)
+	}
+
+	resp, err := p.client.GetProductWithOffers(ctx, &proto.GetProductWithOffersRequest{ProductId: productID, Sort: offerSort})
+	if err != nil {
+		p.logger.Error("Ошибка получения предложений по продукту", zap.String("product_id", productID), zap.Error(err))
+		return models.ProductWithOffers{}, err
+	}
+
+	result := models.ProductWithOffers{Product: productFromProto(resp.GetProduct())}
+	for _, offer := range resp.GetOffers() {
+		result.Offers = append(result.Offers, *offerFromProto(offer))
+	}
+	return result, nil
+}
+
+// offerFromProto - преобразование предложения из gRPC-сообщения, nil для пустого значения
+func offerFromProto(o *proto.Offer) *models.Offer {
+	if o == nil {
+		return nil
+	}
+	offer := &models.Offer{
+		ID:           o.GetId(),
+		ProductID:    o.GetProductId(),
+		Supplier:     o.GetSupplier(),
+		Price:        o.GetPrice(),
+		Currency:     o.GetCurrency(),
+		Quantity:     int(o.GetQuantity()),
+		Reserved:     int(o.GetReserved()),
+		DeliveryDays: int(o.GetDeliveryDays()),
+		URL:          o.GetUrl(),
+		Title:        o.GetTitle(),
+		Condition:    o.GetCondition(),
+		ImageURL:     o.GetImageUrl(),
+		Seller:       o.GetSeller(),
+		Location:     o.GetLocation(),
+	}
+	if o.GetUpdatedAt() != nil {
+		offer.UpdatedAt = o.GetUpdatedAt().AsTime()
+	}
+	return offer
+}
+
+// fitmentsFromProto - преобразование применимости из gRPC-сообщения
+func fitmentsFromProto(resp *proto.ProductFitments) []models.Fitment {
+	fitments := make([]models.Fitment, 0, len(resp.GetFitments()))
+	for _, f := range resp.GetFitments() {
+		fitments = append(fitments, models.Fitment{
+			ID:         f.GetId(),
+			Make:       f.GetMake(),
+			Model:      f.GetModel(),
+			Generation: f.GetGeneration(),
+			YearFrom:   int(f.GetYearFrom()),
+			YearTo:     int(f.GetYearTo()),
+			EngineCode: f.GetEngineCode(),
+			Body:       f.GetBody(),
+		})
+	}
+	return fitments
+}
+
+// vehicleToProto - преобразование автомобиля в gRPC-сообщение
+func vehicleToProto(v *models.Vehicle) *proto.Vehicle {
+	if v == nil {
+		return nil
+	}
+	return &proto.Vehicle{
+		Make:       v.Make,
+		Model:      v.Model,
+		Year:       int32(v.Year),
+		EngineCode: v.EngineCode,
+		Body:       v.Body,
+	}
+}
+
+// productFromProto - преобразование gRPC-сообщения в модель продукта
+func productFromProto(p *proto.Product) models.Product {
+	product := models.Product{
+		ID:          p.GetId(),
+		Name:        p.GetName(),
+		Description: p.GetDescription(),
+		Price:       p.GetPrice(),
+		Category:    p.GetCategory(),
+		Brand:       p.GetBrand(),
+		Article:     p.GetArticle(),
+
+		ArticleNormalized: p.GetArticleNormalized(),
+		BestOffer:         offerFromProto(p.GetBestOffer()),
+		OfferCount:        int(p.GetOfferCount()),
+	}
+	if p.GetAttributes() != nil {
+		product.Attributes = p.GetAttributes().AsMap()
+	}
+	if p.GetCreatedAt() != nil {
+		product.CreatedAt = p.GetCreatedAt().AsTime()
+	}
+	if p.GetUpdatedAt() != nil {
+		product.UpdatedAt = p.GetUpdatedAt().AsTime()
+	}
+	return product
+}
+
+// productToProto - преобразование модели продукта в gRPC-сообщение
+func productToProto(product models.Product) (*proto.Product, error) {
+	var attributes *structpb.Struct
+	if product.Attributes != nil {
+		var err error
+		attributes, err = structpb.NewStruct(product.Attributes)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "некорректные характеристики продукта: %v", err)
+		}
+	}
+
+	return &proto.Product{
+		Id:          product.ID,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+		Category:    product.Category,
+		Attributes:  attributes,
+		Brand:       product.Brand,
+		Article:     product.Article,
+	}, nil
+}
+
+// importChunkSize - размер части файла в потоке импорта
+const importChunkSize = 64 << 10
+
+// importFormats - соответствие форматов прайс-листа значениям gRPC
+var importFormats = map[string]proto.ImportFormat{
+	"csv":  proto.ImportFormat_IMPORT_FORMAT_CSV,
+	"xlsx": proto.ImportFormat_IMPORT_FORMAT_XLSX,
+}
+
+// importStatuses - соответствие состояний задачи импорта значениям gRPC
+var importStatuses = map[proto.ImportStatus]string{
+	proto.ImportStatus_IMPORT_STATUS_PENDING: "pending",
+	proto.ImportStatus_IMPORT_STATUS_RUNNING: "running",
+	proto.ImportStatus_IMPORT_STATUS_DONE:    "done",
+	proto.ImportStatus_IMPORT_STATUS_FAILED:  "failed",
+}
+
+// ImportProducts - передача прайс-листа потоком, возвращает созданную задачу импорта
+func (p *ProductsService) ImportProducts(ctx context.Context, spec models.ImportSpec, file io.Reader) (*models.ImportJob, error) {
+	p.logger.Info("Импорт прайс-листа", zap.String("supplier", spec.Supplier), zap.String("format", spec.Format))
+
+	format, ok := importFormats[spec.Format]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "неизвестный формат прайс-листа %q, ожидался csv или xlsx", spec.Format)
+	}
+
+	stream, err := p.client.ImportProducts(ctx)
+	if err != nil {
+		p.logger.Error("Ошибка открытия потока импорта", zap.Error(err))
+		return nil, err
+	}
+
+	m := spec.Mapping
+	err = stream.Send(&proto.ImportProductsRequest{Payload: &proto.ImportProductsRequest_Spec{Spec: &proto.ImportSpec{
+		Supplier: spec.Supplier,
+		Format:   format,
+		Mapping: &proto.ColumnMapping{
+			Article:      m.Article,
+			Brand:        m.Brand,
+			Name:         m.Name,
+			Price:        m.Price,
+			Quantity:     m.Quantity,
+			DeliveryDays: m.DeliveryDays,
+			Description:  m.Description,
+			Category:     m.Category,
+		},
+		HeaderRow: int32(spec.HeaderRow),
+		Sheet:     spec.Sheet,
+		Delimiter: spec.Delimiter,
+		Currency:  spec.Currency,
+	}}})
+
+	buf := make([]byte, importChunkSize)
+	for err == nil {
+		n, readErr := file.Read(buf)
+		if n > 0 {
+			err = stream.Send(&proto.ImportProductsRequest{Payload: &proto.ImportProductsRequest_Chunk{Chunk: buf[:n]}})
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil && err == nil {
+			stream.CloseSend()
+			return nil, readErr
+		}
+	}
+
+	// При ошибке отправки причина приходит от сервера в CloseAndRecv
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		p.logger.Error("Ошибка импорта прайс-листа", zap.Error(err))
+		return nil, err
+	}
+
+	p.logger.Info("Задача импорта создана", zap.String("job_id", resp.GetId()))
+	return importJobFromProto(resp), nil
+}
+
+// GetImportJob - получение задачи импорта с отчетом по строкам
+func (p *ProductsService) GetImportJob(ctx context.Context, id string) (*models.ImportJob, error) {
+	resp, err := p.client.GetImportJob(ctx, &proto.GetImportJobRequest{Id: id})
+	if err != nil {
+		p.logger.Error("Ошибка получения задачи импорта", zap.String("id", id), zap.Error(err))
+		return nil, err
+	}
+	return importJobFromProto(resp), nil
+}
+
+func importJobFromProto(j *proto.ImportJob) *models.ImportJob {
+	job := &models.ImportJob{
+		ID:              j.GetId(),
+		Supplier:        j.GetSupplier(),
+		Status:          importStatuses[j.GetStatus()],
+		Rows:            j.GetRows(),
+		Imported:        j.GetImported(),
+		Failed:          j.GetFailed(),
+		ProductsCreated: j.GetProductsCreated(),
+		OffersInserted:  j.GetOffersInserted(),
+		OffersUpdated:   j.GetOffersUpdated(),
+		ErrorsTruncated: j.GetErrorsTruncated(),
+		Error:           j.GetError(),
+		CreatedAt:       j.GetCreatedAt().AsTime(),
+	}
+	for name, format := range importFormats {
+		if format == j.GetFormat() {
+			job.Format = name
+		}
+	}
+	for _, e := range j.GetErrors() {
+		job.Errors = append(job.Errors, models.ImportRowError{Row: int(e.GetRow()), Column: e.GetColumn(), Message: e.GetMessage()})
+	}
+	if j.GetFinishedAt() != nil {
+		finishedAt := j.GetFinishedAt().AsTime()
+		job.FinishedAt = &finishedAt
+	}
+	return job
+}
@@ -13,6 +13,7 @@ import (
 
 // UsersService - gRPC клиент для работы с пользователями
 type UsersService struct {
+	conn   *grpc.ClientConn
 	client proto.UserServiceClient
 	logger *zap.Logger
 }
@@ -28,20 +29,31 @@ func NewUsersService(grpcAddress string, logger *zap.Logger) (*UsersService, err
 	client := proto.NewUserServiceClient(conn)
 	logger.Info("gRPC клиент успешно подключен", zap.String("address", grpcAddress))
 
-	return &UsersService{client: client, logger: logger}, nil
+	return &UsersService{conn: conn, client: client, logger: logger}, nil
 }
 
-// GetUsers - получение списка пользователей
-func (s *UsersService) GetUsers(ctx context.Context, page, limit int) ([]models.User, error) {
-	s.logger.Info("Запрос списка пользователей")
+// Close - закрытие gRPC соединения
+func (s *UsersService) Close() error {
+	return s.conn.Close()
+}
+
+// CheckHealth - проверка состояния сервиса через grpc.health.v1
+func (s *UsersService) CheckHealth(ctx context.Context) error {
+	return checkHealth(ctx, s.conn)
+}
+
+// GetUsers - получение страницы пользователей по смещению или курсору
+func (s *UsersService) GetUsers(ctx context.Context, offset, limit int, cursor string) (models.UsersPage, error) {
+	s.logger.Info("Запрос списка пользователей", zap.Int("offset", offset), zap.Int("limit", limit))
 
 	resp, err := s.client.GetUsers(ctx, &proto.GetUsersRequest{
-		Page:  int32(page),
-		Limit: int32(limit),
+		Offset: int32(offset),
+		Limit:  int32(limit),
+		Cursor: cursor,
 	})
 	if err != nil {
 		s.logger.Error("Ошибка получения списка пользователей", zap.Error(err))
-		return nil, err
+		return models.UsersPage{}, err
 	}
 
 	var users []models.User
@@ -58,7 +70,7 @@ func (s *UsersService) GetUsers(ctx context.Context, page, limit int) ([]models.
 	}
 
 	s.logger.Info("Список пользователей успешно получен", zap.Int("count", len(users)))
-	return users, nil
+	return models.UsersPage{Users: users, NextCursor: resp.GetNextCursor()}, nil
 }
 
 // GetUserByID - получение пользователя по ID
@@ -0,0 +1,123 @@
+package vin
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Manufacturer - производитель, закрепленный за кодом WMI
+type Manufacturer struct {
+	Make    string
+	Country string
+	// models - модели по префиксу VDS
+	models []modelCode
+}
+
+type modelCode struct {
+	prefix string
+	model  string
+}
+
+// Model - модель по самому длинному совпавшему префиксу VDS
+func (m Manufacturer) Model(vds string) string {
+	var best modelCode
+	for _, c := range m.models {
+		if strings.HasPrefix(vds, c.prefix) && len(c.prefix) > len(best.prefix) {
+			best = c
+		}
+	}
+	return best.model
+}
+
+// Table - таблица кодов производителей
+type Table struct {
+	manufacturers map[string]*Manufacturer
+}
+
+// Lookup - поиск производителя по WMI.
+// Если полный код не найден, используется двухсимвольный префикс: так в таблице задаются диапазоны кодов одного производителя.
+func (t *Table) Lookup(wmi string) (Manufacturer, bool) {
+	wmi = strings.ToUpper(wmi)
+	if m, ok := t.manufacturers[wmi]; ok {
+		return *m, true
+	}
+	if len(wmi) > 2 {
+		if m, ok := t.manufacturers[wmi[:2]]; ok {
+			return *m, true
+		}
+	}
+	return Manufacturer{}, false
+}
+
+// Len - количество кодов производителей в таблице
+func (t *Table) Len() int {
+	return len(t.manufacturers)
+}
+
+// LoadTableFile - загрузка таблицы кодов из файла
+func LoadTableFile(path string) (*Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось открыть таблицу кодов VIN: %w", err)
+	}
+	defer f.Close()
+
+	table, err := LoadTable(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return table, nil
+}
+
+// LoadTable - загрузка таблицы кодов в формате CSV с разделителем ";".
+// Строка производителя: wmi;марка;страна. Строка модели: wmi;марка;страна;префикс VDS;модель.
+// Строки, начинающиеся с #, пропускаются.
+func LoadTable(r io.Reader) (*Table, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = ';'
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	table := &Table{manufacturers: make(map[string]*Manufacturer)}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		if len(record) != 3 && len(record) != 5 {
+			return nil, fmt.Errorf("строка %d: ожидалось 3 или 5 полей, получено %d", line, len(record))
+		}
+		wmi := strings.ToUpper(strings.TrimSpace(record[0]))
+		if len(wmi) != 2 && len(wmi) != 3 {
+			return nil, fmt.Errorf("строка %d: код WMI %q должен состоять из 2 или 3 символов", line, wmi)
+		}
+
+		m, ok := table.manufacturers[wmi]
+		if !ok {
+			m = &Manufacturer{}
+			table.manufacturers[wmi] = m
+		}
+		m.Make = strings.TrimSpace(record[1])
+		m.Country = strings.TrimSpace(record[2])
+
+		if len(record) == 5 {
+			prefix := strings.ToUpper(strings.TrimSpace(record[3]))
+			model := strings.TrimSpace(record[4])
+			if prefix == "" || len(prefix) > 6 || model == "" {
+				return nil, fmt.Errorf("строка %d: префикс VDS должен содержать 1-6 символов, модель обязательна", line)
+			}
+			m.models = append(m.models, modelCode{prefix: prefix, model: model})
+		}
+	}
+	return table, nil
+}
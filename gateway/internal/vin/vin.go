@@ -0,0 +1,175 @@
+// Package vin - разбор идентификационного номера автомобиля (ISO 3779):
+// код производителя (WMI), описательная часть (VDS), модельный год и контрольная цифра.
+package vin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Length - длина VIN
+const Length = 17
+
+var (
+	// ErrInvalidLength - длина VIN отличается от 17 символов
+	ErrInvalidLength = errors.New("VIN должен состоять из 17 символов")
+	// ErrInvalidChar - VIN содержит недопустимый символ (I, O, Q или не латиницу/цифру)
+	ErrInvalidChar = errors.New("VIN содержит недопустимый символ")
+	// ErrChecksum - контрольная цифра североамериканского VIN не совпала
+	ErrChecksum = errors.New("неверная контрольная цифра VIN")
+)
+
+// Info - результат разбора VIN
+type Info struct {
+	VIN string
+	// WMI - код производителя (позиции 1-3)
+	WMI string
+	// VDS - описательная часть (позиции 4-9)
+	VDS string
+	// VIS - указательная часть (позиции 10-17)
+	VIS string
+	// Region - регион по первому символу WMI
+	Region string
+	// NorthAmerican - VIN выпущен для Северной Америки, контрольная цифра обязательна
+	NorthAmerican bool
+	// Make, Country - производитель из таблицы кодов, пустые, если код неизвестен
+	Make    string
+	Country string
+	// Model - модель по префиксу VDS из таблицы кодов, пустая, если не определена
+	Model string
+	// Year - модельный год, 0, если не удалось определить
+	Year int
+}
+
+// Decoder - декодер VIN с таблицей кодов производителей
+type Decoder struct {
+	table *Table
+	now   func() time.Time
+}
+
+// NewDecoder - конструктор декодера. Таблица может быть nil, тогда производитель не определяется.
+func NewDecoder(table *Table) *Decoder {
+	if table == nil {
+		table = &Table{}
+	}
+	return &Decoder{table: table, now: time.Now}
+}
+
+// Decode - разбор VIN. Регистр и пробелы по краям не учитываются.
+func (d *Decoder) Decode(raw string) (*Info, error) {
+	vin := strings.ToUpper(strings.TrimSpace(raw))
+	if len(vin) != Length {
+		return nil, ErrInvalidLength
+	}
+	for i := 0; i < Length; i++ {
+		if _, ok := transliteration(vin[i]); !ok {
+			return nil, fmt.Errorf("%w %q в позиции %d", ErrInvalidChar, vin[i], i+1)
+		}
+	}
+
+	info := &Info{
+		VIN:           vin,
+		WMI:           vin[0:3],
+		VDS:           vin[3:9],
+		VIS:           vin[9:17],
+		Region:        region(vin[0]),
+		NorthAmerican: vin[0] >= '1' && vin[0] <= '5',
+	}
+
+	if info.NorthAmerican {
+		if want := checkDigit(vin); vin[8] != want {
+			return nil, fmt.Errorf("%w: ожидалась %q, получена %q", ErrChecksum, want, vin[8])
+		}
+	}
+
+	if m, ok := d.table.Lookup(info.WMI); ok {
+		info.Make = m.Make
+		info.Country = m.Country
+		info.Model = m.Model(info.VDS)
+	}
+	info.Year = modelYear(vin, info.NorthAmerican, d.now())
+	return info, nil
+}
+
+// weights - веса позиций для расчета контрольной цифры
+var weights = [Length]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// checkDigit - расчет контрольной цифры (позиция 9) по правилам NHTSA
+func checkDigit(vin string) byte {
+	sum := 0
+	for i := 0; i < Length; i++ {
+		v, _ := transliteration(vin[i])
+		sum += v * weights[i]
+	}
+	if rem := sum % 11; rem != 10 {
+		return byte('0' + rem)
+	}
+	return 'X'
+}
+
+// transliteration - числовое значение символа VIN; I, O и Q не допускаются
+func transliteration(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'H':
+		return int(c-'A') + 1, true
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1, true
+	case c == 'P':
+		return 7, true
+	case c == 'R':
+		return 9, true
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2, true
+	}
+	return 0, false
+}
+
+// yearCodes - коды модельного года в позиции 10, начиная с 1980 года; цикл повторяется каждые 30 лет
+const yearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+// modelYear - определение модельного года.
+// Для североамериканских VIN цикл выбирается по позиции 7: цифра - 1980-2009, буква - 2010-2039.
+// Для остальных берется последний год цикла, не превышающий следующий календарный год.
+func modelYear(vin string, northAmerican bool, now time.Time) int {
+	idx := strings.IndexByte(yearCodes, vin[9])
+	if idx < 0 {
+		return 0
+	}
+	year := 1980 + idx
+
+	if northAmerican {
+		if vin[6] < '0' || vin[6] > '9' {
+			year += 30
+		}
+		return year
+	}
+
+	limit := now.Year() + 1
+	for year+30 <= limit {
+		year += 30
+	}
+	return year
+}
+
+// region - регион по первому символу WMI
+func region(c byte) string {
+	switch {
+	case c >= 'A' && c <= 'H':
+		return "Африка"
+	case c >= 'J' && c <= 'R':
+		return "Азия"
+	case c >= 'S' && c <= 'Z':
+		return "Европа"
+	case c >= '1' && c <= '5':
+		return "Северная Америка"
+	case c == '6' || c == '7':
+		return "Океания"
+	case c == '8' || c == '9':
+		return "Южная Америка"
+	}
+	return ""
+}
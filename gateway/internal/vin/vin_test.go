@@ -0,0 +1,162 @@
+package vin
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testTable = `# wmi;марка;страна;префикс VDS;модель
+1HG;Honda;США
+1HG;Honda;США;CM8;Accord
+1HG;Honda;США;CM;Accord (старые)
+1M8;Motor Coach Industries;США
+WVW;Volkswagen;Германия;1J;Golf
+JT;Toyota;Япония
+`
+
+// withCheckDigit - VIN с рассчитанной контрольной цифрой в позиции 9
+func withCheckDigit(vin string) string {
+	return vin[:8] + string(checkDigit(vin)) + vin[9:]
+}
+
+func newTestDecoder(t *testing.T) *Decoder {
+	t.Helper()
+	table, err := LoadTable(strings.NewReader(testTable))
+	if err != nil {
+		t.Fatalf("LoadTable: %v", err)
+	}
+	d := NewDecoder(table)
+	d.now = func() time.Time { return time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC) }
+	return d
+}
+
+func TestDecodeValidation(t *testing.T) {
+	d := newTestDecoder(t)
+	tests := []struct {
+		name string
+		vin  string
+		err  error
+	}{
+		{name: "верная контрольная цифра", vin: "1HGCM82633A004352"},
+		{name: "контрольная цифра X", vin: "1M8GDM9AXKP042788"},
+		{name: "регистр и пробелы", vin: "  1hgcm82633a004352 "},
+		{name: "неверная контрольная цифра", vin: "1HGCM82643A004352", err: ErrChecksum},
+		{name: "X вместо цифры", vin: "1HGCM826X3A004352", err: ErrChecksum},
+		{name: "контрольная цифра не проверяется вне Северной Америки", vin: "WVWZZZ1JZXW000001"},
+		{name: "буква I", vin: "1HGCM82633A0I4352", err: ErrInvalidChar},
+		{name: "буква O", vin: "1HGCM82633AO04352", err: ErrInvalidChar},
+		{name: "буква Q", vin: "WVWZZZ1JZXW00000Q", err: ErrInvalidChar},
+		{name: "не латиница", vin: "WVWZZZ1JZXW00000-", err: ErrInvalidChar},
+		{name: "короткий", vin: "1HGCM82633A00435", err: ErrInvalidLength},
+		{name: "длинный", vin: "1HGCM82633A0043521", err: ErrInvalidLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := d.Decode(tt.vin)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Decode(%q): ошибка %v, ожидалась %v", tt.vin, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCheckDigit(t *testing.T) {
+	tests := []struct {
+		vin  string
+		want byte
+	}{
+		{vin: "1HGCM82633A004352", want: '3'},
+		{vin: "1M8GDM9AXKP042788", want: 'X'},
+		{vin: "11111111111111111", want: '1'},
+	}
+	for _, tt := range tests {
+		if got := checkDigit(tt.vin); got != tt.want {
+			t.Errorf("checkDigit(%q) = %q, ожидалась %q", tt.vin, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeModelYear(t *testing.T) {
+	d := newTestDecoder(t)
+	tests := []struct {
+		name string
+		vin  string
+		want int
+	}{
+		// Северная Америка: цикл выбирается по позиции 7
+		{name: "цифра в позиции 7 - первый цикл", vin: "1HGCM82633A004352", want: 2003},
+		{name: "буква в позиции 7 - второй цикл", vin: withCheckDigit("1HGCM8A603A004352"), want: 2033},
+		{name: "код K с цифрой в позиции 7", vin: "1M8GDM9AXKP042788", want: 1989},
+		// Остальные регионы: последний год цикла не позже следующего календарного года (2027)
+		{name: "A - 2010, а не 1980", vin: "WVWZZZ1JZAW000001", want: 2010},
+		{name: "T - текущий год", vin: "WVWZZZ1JZTW000001", want: 2026},
+		{name: "V - следующий год", vin: "WVWZZZ1JZVW000001", want: 2027},
+		{name: "W - 1998, 2028 еще не наступил", vin: "WVWZZZ1JZWW000001", want: 1998},
+		{name: "Y - 2000", vin: "WVWZZZ1JZYW000001", want: 2000},
+		{name: "код 0 не обозначает год", vin: "WVWZZZ1JZ0W000001", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := d.Decode(tt.vin)
+			if err != nil {
+				t.Fatalf("Decode(%q): %v", tt.vin, err)
+			}
+			if info.Year != tt.want {
+				t.Errorf("Decode(%q): год %d, ожидался %d", tt.vin, info.Year, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeManufacturer(t *testing.T) {
+	d := newTestDecoder(t)
+	tests := []struct {
+		name                 string
+		vin                  string
+		make, country, model string
+		region               string
+	}{
+		{name: "самый длинный префикс VDS", vin: "1HGCM82633A004352", make: "Honda", country: "США", model: "Accord", region: "Северная Америка"},
+		{name: "модель не определена", vin: "1M8GDM9AXKP042788", make: "Motor Coach Industries", country: "США", region: "Северная Америка"},
+		{name: "двухсимвольный префикс WMI", vin: "JTDKB20U093000001", make: "Toyota", country: "Япония", region: "Азия"},
+		{name: "код отсутствует в таблице", vin: "XTA21099033000001", region: "Европа"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := d.Decode(tt.vin)
+			if err != nil {
+				t.Fatalf("Decode(%q): %v", tt.vin, err)
+			}
+			if info.Make != tt.make || info.Country != tt.country || info.Model != tt.model || info.Region != tt.region {
+				t.Errorf("Decode(%q): %q/%q/%q/%q, ожидалось %q/%q/%q/%q", tt.vin,
+					info.Make, info.Country, info.Model, info.Region, tt.make, tt.country, tt.model, tt.region)
+			}
+		})
+	}
+}
+
+func TestDecodeWithoutTable(t *testing.T) {
+	info, err := NewDecoder(nil).Decode("1HGCM82633A004352")
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if info.Make != "" || info.WMI != "1HG" || info.VDS != "CM8263" || info.VIS != "3A004352" {
+		t.Errorf("разбор без таблицы: %+v", info)
+	}
+}
+
+func TestLoadTableErrors(t *testing.T) {
+	tests := []string{
+		"1HG;Honda\n",
+		"1HGX;Honda;США\n",
+		"1HG;Honda;США;ABCDEFG;Модель\n",
+		"1HG;Honda;США;CM;\n",
+	}
+	for _, data := range tests {
+		if _, err := LoadTable(strings.NewReader(data)); err == nil {
+			t.Errorf("LoadTable(%q): ожидалась ошибка", data)
+		}
+	}
+}
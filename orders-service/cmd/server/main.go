@@ -2,17 +2,28 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
+	"order-service/internal/catalog"
+	"order-service/internal/config"
 	"order-service/internal/delivery"
+	"order-service/internal/healthcheck"
+	"order-service/internal/pagination"
+	"order-service/internal/payment"
 	"order-service/internal/proto" // Путь к вашему сгенерированному файлу
 	"order-service/internal/repository"
 	"order-service/internal/usecase"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap" // Импортируем zap для логирования
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -24,8 +35,14 @@ func main() {
 	}
 	defer logger.Sync() // Закрытие логгера при завершении работы программы
 
+	// Загружаем конфигурацию
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Ошибка загрузки конфигурации", zap.Error(err))
+	}
+
 	// Подключаемся к MongoDB
-	clientOptions := options.Client().ApplyURI("mongodb://localhost:27017")
+	clientOptions := options.Client().ApplyURI(cfg.Mongo.URI)
 	client, err := mongo.Connect(context.Background(), clientOptions)
 	if err != nil {
 		logger.Fatal("Ошибка при подключении к MongoDB", zap.Error(err))
@@ -38,30 +55,128 @@ func main() {
 	}
 
 	// Получаем доступ к нужной базе данных
-	db := client.Database("productDB") // Используйте имя вашей базы данных
+	db := client.Database(cfg.Mongo.Database)
 
 	// Создаем gRPC сервер
 	server := grpc.NewServer()
 
 	// Создаем репозиторий, сервис и обработчик
+	cursorSecret := []byte(cfg.Pagination.CursorSecret)
+	if len(cursorSecret) == 0 {
+		logger.Warn("CURSOR_SECRET не задан, курсоры пагинации будут недействительны после перезапуска")
+		if cursorSecret, err = pagination.RandomSecret(); err != nil {
+			logger.Fatal("Ошибка генерации секрета курсоров", zap.Error(err))
+		}
+	}
+
+	// Клиент сервиса продуктов для расчета цен заказа
+	products, err := catalog.NewProductsCatalog(cfg.Services.Products, logger)
+	if err != nil {
+		logger.Fatal("Ошибка подключения к сервису продуктов", zap.Error(err))
+	}
+	defer products.Close()
+
+	carts := repository.NewCartRepository(db)
+	if err := carts.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Не удалось создать индексы коллекции корзин", zap.Error(err))
+	}
+	sagas := repository.NewSagaRepository(db)
+	if err := sagas.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Не удалось создать индексы коллекции саг", zap.Error(err))
+	}
+	payments := payment.NewStub(cfg.Payments.DeclineAbove, cfg.Payments.FailureRate, logger)
 	repository := repository.NewOrderRepository(db)
-	service := usecase.NewOrderService(repository)
+	service := usecase.NewOrderService(repository, sagas, products, payments, pagination.NewCursorSigner(cursorSecret))
 	handler := delivery.NewOrderHandler(service, logger) // Передаем логгер в обработчик
 
+	cartHandler := delivery.NewCartHandler(usecase.NewCartService(carts, service, products), logger)
+
 	// Регистрируем сервис (например, ProductService)
 	proto.RegisterOrderServiceServer(server, handler)
+	proto.RegisterCartServiceServer(server, cartHandler)
+
+	// Регистрируем стандартный сервис проверки состояния
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
 
 	// Включаем рефлексию
 	reflection.Register(server)
 
 	// Настроим и запустим сервер
-	listener, err := net.Listen("tcp", ":9093")
+	addr := fmt.Sprintf(":%d", cfg.GRPC.Port)
+	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		logger.Fatal("Ошибка при создании слушателя", zap.Error(err))
 	}
 
-	logger.Info("Сервер запущен на :9093")
-	if err := server.Serve(listener); err != nil {
-		logger.Fatal("Ошибка при запуске сервера", zap.Error(err))
+	// Останавливаемся по SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Статус сервера отражает доступность MongoDB
+	go healthcheck.WatchMongo(ctx, client, healthServer, logger, proto.OrderService_ServiceDesc.ServiceName)
+
+	// Повторы шагов оформления заказов и продолжение саг, прерванных перезапуском
+	go resumeSagas(ctx, service, cfg.Saga.PollInterval, logger)
+
+	go func() {
+		logger.Info("Сервер запущен", zap.String("addr", addr))
+		if err := server.Serve(listener); err != nil {
+			logger.Fatal("Ошибка при запуске сервера", zap.Error(err))
+		}
+	}()
+
+	<-ctx.Done()
+	logger.Info("Получен сигнал завершения, останавливаем сервер", zap.Duration("timeout", cfg.ShutdownTimeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	// Сообщаем клиентам, что сервер больше не принимает запросы
+	healthServer.Shutdown()
+	gracefulStop(shutdownCtx, server, logger)
+
+	// Закрываем соединение с MongoDB, по истечении таймаута активные операции прерываются
+	if err := client.Disconnect(shutdownCtx); err != nil {
+		logger.Error("Ошибка при отключении от MongoDB", zap.Error(err))
+	}
+	logger.Info("Сервер остановлен")
+}
+
+// resumeSagas - периодическое продолжение саг оформления заказов до отмены ctx, первый проход - сразу при запуске
+func resumeSagas(ctx context.Context, service *usecase.OrderService, interval time.Duration, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		processed, err := service.ResumeSagas(ctx)
+		if err != nil {
+			logger.Error("Ошибка продолжения саг оформления заказов", zap.Error(err))
+		}
+		if processed > 0 {
+			logger.Info("Продолжены саги оформления заказов", zap.Int("count", processed))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// gracefulStop - ожидание завершения активных запросов, по истечении ctx соединения закрываются принудительно
+func gracefulStop(ctx context.Context, server *grpc.Server, logger *zap.Logger) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		logger.Warn("Истекло время ожидания завершения запросов, принудительная остановка")
+		server.Stop()
 	}
 }
@@ -0,0 +1,173 @@
+// Package catalog - получение текущих цен продуктов из сервиса продуктов
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+
+	"order-service/internal/models"
+	"order-service/internal/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// ProductsCatalog - gRPC клиент сервиса продуктов
+type ProductsCatalog struct {
+	conn   *grpc.ClientConn
+	client proto.ProductServiceClient
+	logger *zap.Logger
+}
+
+// NewProductsCatalog - конструктор клиента сервиса продуктов
+func NewProductsCatalog(grpcAddress string, logger *zap.Logger) (*ProductsCatalog, error) {
+	conn, err := grpc.NewClient(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось подключиться к gRPC серверу: %w", err)
+	}
+	logger.Info("gRPC клиент успешно подключен", zap.String("address", grpcAddress))
+
+	return &ProductsCatalog{conn: conn, client: proto.NewProductServiceClient(conn), logger: logger}, nil
+}
+
+// Close - закрытие gRPC соединения
+func (c *ProductsCatalog) Close() error {
+	return c.conn.Close()
+}
+
+// Product - продукт с предложениями поставщиков.
+// Возвращает models.ErrProductNotFound для неизвестного продукта
+// и models.ErrCatalogUnavailable, если сервис продуктов не ответил.
+func (c *ProductsCatalog) Product(ctx context.Context, id string) (*models.CatalogProduct, error) {
+	resp, err := c.client.GetProductWithOffers(ctx, &proto.GetProductWithOffersRequest{ProductId: id})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound, codes.InvalidArgument:
+			return nil, models.ErrProductNotFound
+		}
+		c.logger.Error("Ошибка получения продукта из каталога", zap.String("product_id", id), zap.Error(err))
+		return nil, fmt.Errorf("%w: %v", models.ErrCatalogUnavailable, err)
+	}
+
+	product := &models.CatalogProduct{
+		ID:    resp.GetProduct().GetId(),
+		Name:  resp.GetProduct().GetName(),
+		Price: toPrice(resp.GetProduct().GetPrice()),
+	}
+	for _, o := range resp.GetOffers() {
+		product.Offers = append(product.Offers, models.CatalogOffer{
+			ID:       o.GetId(),
+			Supplier: o.GetSupplier(),
+			Price:    toPrice(o.GetPrice()),
+			Currency: o.GetCurrency(),
+			Quantity: max(int(o.GetQuantity()-o.GetReserved()), 0),
+		})
+	}
+	return product, nil
+}
+
+// ReserveStock - резерв остатков предложений под заказ на время по умолчанию сервиса продуктов.
+// Позиции без предложения не резервируются. Возвращает время истечения резерва,
+// models.ErrOutOfStock, если остатка хватает не по всем позициям,
+// и *models.ValidationError, если сервис продуктов отклонил позиции резерва.
+func (c *ProductsCatalog) ReserveStock(ctx context.Context, orderID string, items []models.OrderItem) (time.Time, error) {
+	req := &proto.ReserveStockRequest{OrderId: orderID}
+	// itemIndex - номер позиции заказа для каждой позиции резерва
+	var itemIndex []int
+	for i, item := range items {
+		if item.OfferID == "" {
+			continue
+		}
+		itemIndex = append(itemIndex, i)
+		req.Items = append(req.Items, &proto.ReservationItem{
+			ProductId: item.ProductID,
+			OfferId:   item.OfferID,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+	if len(req.Items) == 0 {
+		return time.Time{}, nil
+	}
+
+	resp, err := c.client.ReserveStock(ctx, req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return time.Time{}, fmt.Errorf("%w: %s", models.ErrOutOfStock, status.Convert(err).Message())
+		case codes.InvalidArgument:
+			return time.Time{}, reservationValidationError(status.Convert(err), itemIndex)
+		}
+		c.logger.Error("Ошибка резерва остатков", zap.String("order_id", orderID), zap.Error(err))
+		return time.Time{}, fmt.Errorf("%w: %v", models.ErrCatalogUnavailable, err)
+	}
+	return resp.GetExpiresAt().AsTime(), nil
+}
+
+// ConfirmReservation - списание остатков, зарезервированных под заказ.
+// Для отсутствующего резерва возвращается models.ErrReservationNotFound,
+// для снятого или истекшего - models.ErrReservationExpired.
+func (c *ProductsCatalog) ConfirmReservation(ctx context.Context, orderID string) error {
+	_, err := c.client.ConfirmReservation(ctx, &proto.ReservationRequest{OrderId: orderID})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return models.ErrReservationNotFound
+	case codes.FailedPrecondition:
+		return models.ErrReservationExpired
+	}
+	c.logger.Error("Ошибка подтверждения резерва", zap.String("order_id", orderID), zap.Error(err))
+	return fmt.Errorf("%w: %v", models.ErrCatalogUnavailable, err)
+}
+
+// ReleaseReservation - снятие резерва заказа, остатки возвращаются в предложения.
+// Отсутствие резерва не считается ошибкой.
+func (c *ProductsCatalog) ReleaseReservation(ctx context.Context, orderID string) error {
+	_, err := c.client.ReleaseReservation(ctx, &proto.ReservationRequest{OrderId: orderID})
+	if err == nil || status.Code(err) == codes.NotFound {
+		return nil
+	}
+	c.logger.Error("Ошибка снятия резерва", zap.String("order_id", orderID), zap.Error(err))
+	return fmt.Errorf("%w: %v", models.ErrCatalogUnavailable, err)
+}
+
+// reservationItemField - поле позиции резерва, например items[0].offer_id
+var reservationItemField = regexp.MustCompile(`^items\[(\d+)\]`)
+
+// reservationValidationError - нарушения из ответа сервиса продуктов с номерами позиций заказа вместо номеров позиций резерва
+func reservationValidationError(st *status.Status, itemIndex []int) error {
+	verr := &models.ValidationError{}
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range badRequest.GetFieldViolations() {
+			field := reservationItemField.ReplaceAllStringFunc(v.GetField(), func(m string) string {
+				n, err := strconv.Atoi(reservationItemField.FindStringSubmatch(m)[1])
+				if err != nil || n >= len(itemIndex) {
+					return m
+				}
+				return fmt.Sprintf("items[%d]", itemIndex[n])
+			})
+			verr.Add(field, v.GetDescription())
+		}
+	}
+	if len(verr.Violations) == 0 {
+		verr.Add("items", st.Message())
+	}
+	return verr
+}
+
+// toPrice - цена каталога (float32) с точностью до копеек
+func toPrice(p float32) float64 {
+	return math.Round(float64(p)*100) / 100
+}
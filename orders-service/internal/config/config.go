@@ -0,0 +1,201 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config - конфигурация сервиса заказов
+type Config struct {
+	GRPC       GRPCConfig       `yaml:"grpc"`
+	Mongo      MongoConfig      `yaml:"mongo"`
+	Services   ServicesConfig   `yaml:"services"`
+	Pagination PaginationConfig `yaml:"pagination"`
+	Payments   PaymentsConfig   `yaml:"payments"`
+	Saga       SagaConfig       `yaml:"saga"`
+	// ShutdownTimeout - время на завершение активных запросов при остановке
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// GRPCConfig - параметры gRPC-сервера
+type GRPCConfig struct {
+	Port int `yaml:"port"`
+}
+
+// MongoConfig - параметры подключения к MongoDB
+type MongoConfig struct {
+	URI      string `yaml:"uri"`
+	Database string `yaml:"database"`
+}
+
+// ServicesConfig - адреса gRPC-сервисов
+type ServicesConfig struct {
+	// Products - сервис продуктов, источник цен позиций заказа
+	Products string `yaml:"products"`
+}
+
+// PaginationConfig - параметры курсорной пагинации
+type PaginationConfig struct {
+	// CursorSecret - секрет подписи курсоров; если не задан, генерируется при запуске
+	CursorSecret string `yaml:"cursor_secret"`
+}
+
+// PaymentsConfig - параметры заглушки платежного сервиса
+type PaymentsConfig struct {
+	// DeclineAbove - сумма заказа, выше которой платеж отклоняется, 0 - без ограничения
+	DeclineAbove float64 `yaml:"decline_above"`
+	// FailureRate - доля запросов, завершающихся временной ошибкой, от 0 до 1
+	FailureRate float64 `yaml:"failure_rate"`
+}
+
+// SagaConfig - параметры саги оформления заказа
+type SagaConfig struct {
+	// PollInterval - период поиска саг для повтора и продолжения после перезапуска
+	PollInterval time.Duration `yaml:"poll_interval"`
+}
+
+// Load - загрузка конфигурации.
+// Порядок применения: значения по умолчанию, YAML-файл из CONFIG_PATH (если задан), переменные окружения.
+func Load() (*Config, error) {
+	cfg := &Config{
+		GRPC: GRPCConfig{
+			Port: 9093,
+		},
+		Mongo: MongoConfig{
+			URI:      "mongodb://localhost:27017",
+			Database: "productDB",
+		},
+		Services: ServicesConfig{
+			Products: "localhost:9091",
+		},
+		Saga: SagaConfig{
+			PollInterval: 5 * time.Second,
+		},
+		ShutdownTimeout: 15 * time.Second,
+	}
+
+	if path := os.Getenv("CONFIG_PATH"); path != "" {
+		if err := loadYAML(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("невалидная конфигурация: %w", err)
+	}
+	return cfg, nil
+}
+
+// Validate - проверка корректности конфигурации
+func (c *Config) Validate() error {
+	var errs []error
+	if c.GRPC.Port <= 0 || c.GRPC.Port > 65535 {
+		errs = append(errs, fmt.Errorf("grpc.port (GRPC_PORT): порт %d вне диапазона 1-65535", c.GRPC.Port))
+	}
+	if c.Mongo.URI == "" {
+		errs = append(errs, errors.New("mongo.uri (MONGO_URI): значение не задано"))
+	}
+	if c.Mongo.Database == "" {
+		errs = append(errs, errors.New("mongo.database (MONGO_DATABASE): значение не задано"))
+	}
+	if c.Services.Products == "" {
+		errs = append(errs, errors.New("services.products (PRODUCTS_SERVICE_ADDR): значение не задано"))
+	}
+	if c.Payments.DeclineAbove < 0 {
+		errs = append(errs, errors.New("payments.decline_above (PAYMENT_STUB_DECLINE_ABOVE): сумма не может быть отрицательной"))
+	}
+	if c.Payments.FailureRate < 0 || c.Payments.FailureRate > 1 {
+		errs = append(errs, fmt.Errorf("payments.failure_rate (PAYMENT_STUB_FAILURE_RATE): доля %v вне диапазона 0-1", c.Payments.FailureRate))
+	}
+	if c.Saga.PollInterval <= 0 {
+		errs = append(errs, errors.New("saga.poll_interval (SAGA_POLL_INTERVAL): время должно быть положительным"))
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown_timeout (SHUTDOWN_TIMEOUT): время должно быть положительным"))
+	}
+	return errors.Join(errs...)
+}
+
+// loadEnv - переопределение параметров переменными окружения
+func (c *Config) loadEnv() error {
+	envString(&c.Mongo.URI, "MONGO_URI")
+	envString(&c.Mongo.Database, "MONGO_DATABASE")
+	envString(&c.Services.Products, "PRODUCTS_SERVICE_ADDR")
+	envString(&c.Pagination.CursorSecret, "CURSOR_SECRET")
+	return errors.Join(
+		envInt(&c.GRPC.Port, "GRPC_PORT"),
+		envFloat(&c.Payments.DeclineAbove, "PAYMENT_STUB_DECLINE_ABOVE"),
+		envFloat(&c.Payments.FailureRate, "PAYMENT_STUB_FAILURE_RATE"),
+		envDuration(&c.Saga.PollInterval, "SAGA_POLL_INTERVAL"),
+		envDuration(&c.ShutdownTimeout, "SHUTDOWN_TIMEOUT"),
+	)
+}
+
+func loadYAML(path string, cfg *Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("не удалось открыть файл конфигурации: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("не удалось разобрать файл конфигурации %s: %w", path, err)
+	}
+	return nil
+}
+
+func envString(dst *string, key string) {
+	if value, ok := os.LookupEnv(key); ok {
+		*dst = value
+	}
+}
+
+func envInt(dst *int, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалось целое число, получено %q", key, value)
+	}
+	*dst = n
+	return nil
+}
+
+func envFloat(dst *float64, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалось число, получено %q", key, value)
+	}
+	*dst = f
+	return nil
+}
+
+func envDuration(dst *time.Duration, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалась длительность (например, 15s), получено %q", key, value)
+	}
+	*dst = d
+	return nil
+}
@@ -0,0 +1,147 @@
+package delivery
+
+import (
+	"context"
+	"order-service/internal/models"
+	"order-service/internal/proto"
+	"order-service/internal/usecase"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ proto.CartServiceServer = (*CartHandler)(nil)
+
+// CartHandler - gRPC обработчик корзин
+type CartHandler struct {
+	proto.UnimplementedCartServiceServer
+	service *usecase.CartService
+	logger  *zap.Logger
+}
+
+// NewCartHandler - конструктор обработчика корзин
+func NewCartHandler(service *usecase.CartService, logger *zap.Logger) *CartHandler {
+	return &CartHandler{service: service, logger: logger}
+}
+
+// GetCart - корзина по текущим ценам каталога
+func (h *CartHandler) GetCart(ctx context.Context, req *proto.GetCartRequest) (*proto.Cart, error) {
+	view, err := h.service.Get(ctx, ownerFromProto(req.Owner))
+	if err != nil {
+		h.logger.Error("Ошибка получения корзины", zap.Error(err))
+		return nil, toStatusError(err, "не удалось получить корзину")
+	}
+	return convertToProtoCart(view), nil
+}
+
+// AddCartItem - добавление товара в корзину
+func (h *CartHandler) AddCartItem(ctx context.Context, req *proto.AddCartItemRequest) (*proto.Cart, error) {
+	h.logger.Info("Добавление в корзину", zap.String("product_id", req.ProductId), zap.Int32("quantity", req.Quantity))
+
+	view, err := h.service.Add(ctx, ownerFromProto(req.Owner), models.CartItem{
+		ProductID: req.ProductId,
+		OfferID:   req.OfferId,
+		Quantity:  int(req.Quantity),
+	})
+	if err != nil {
+		h.logger.Error("Ошибка добавления в корзину", zap.String("product_id", req.ProductId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось добавить товар в корзину")
+	}
+	return convertToProtoCart(view), nil
+}
+
+// UpdateCartItem - изменение количества позиции корзины
+func (h *CartHandler) UpdateCartItem(ctx context.Context, req *proto.UpdateCartItemRequest) (*proto.Cart, error) {
+	view, err := h.service.SetQuantity(ctx, ownerFromProto(req.Owner), models.CartItem{
+		ProductID: req.ProductId,
+		OfferID:   req.OfferId,
+		Quantity:  int(req.Quantity),
+	})
+	if err != nil {
+		h.logger.Error("Ошибка изменения позиции корзины", zap.String("product_id", req.ProductId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось изменить позицию корзины")
+	}
+	return convertToProtoCart(view), nil
+}
+
+// RemoveCartItem - удаление позиции из корзины
+func (h *CartHandler) RemoveCartItem(ctx context.Context, req *proto.RemoveCartItemRequest) (*proto.Cart, error) {
+	view, err := h.service.Remove(ctx, ownerFromProto(req.Owner), req.ProductId, req.OfferId)
+	if err != nil {
+		h.logger.Error("Ошибка удаления позиции корзины", zap.String("product_id", req.ProductId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось удалить позицию корзины")
+	}
+	return convertToProtoCart(view), nil
+}
+
+// ClearCart - очистка корзины
+func (h *CartHandler) ClearCart(ctx context.Context, req *proto.ClearCartRequest) (*proto.Cart, error) {
+	view, err := h.service.Clear(ctx, ownerFromProto(req.Owner))
+	if err != nil {
+		h.logger.Error("Ошибка очистки корзины", zap.Error(err))
+		return nil, toStatusError(err, "не удалось очистить корзину")
+	}
+	return convertToProtoCart(view), nil
+}
+
+// MergeCarts - перенос гостевой корзины в корзину пользователя
+func (h *CartHandler) MergeCarts(ctx context.Context, req *proto.MergeCartsRequest) (*proto.Cart, error) {
+	h.logger.Info("Перенос гостевой корзины", zap.String("user_id", req.GetRequester().GetUserId()))
+
+	view, err := h.service.Merge(ctx, requesterFromProto(req.Requester), req.GuestId)
+	if err != nil {
+		h.logger.Error("Ошибка переноса гостевой корзины", zap.Error(err))
+		return nil, toStatusError(err, "не удалось перенести гостевую корзину")
+	}
+	return convertToProtoCart(view), nil
+}
+
+// Checkout - оформление заказа из корзины
+func (h *CartHandler) Checkout(ctx context.Context, req *proto.CheckoutRequest) (*proto.CreateOrderResponse, error) {
+	h.logger.Info("Оформление заказа из корзины", zap.String("user_id", req.GetRequester().GetUserId()))
+
+	order, err := h.service.Checkout(ctx, requesterFromProto(req.Requester), req.ExpectedTotal)
+	if err != nil {
+		h.logger.Error("Ошибка оформления заказа из корзины", zap.Error(err))
+		return nil, toStatusError(err, "не удалось оформить заказ")
+	}
+
+	h.logger.Info("Заказ оформлен из корзины", zap.String("order_id", order.ID))
+	return &proto.CreateOrderResponse{Order: convertToProtoOrder(order)}, nil
+}
+
+// ownerFromProto - владелец корзины: авторизованный пользователь или гость
+func ownerFromProto(owner *proto.CartOwner) models.CartOwner {
+	return models.CartOwner{
+		UserID:  owner.GetRequester().GetUserId(),
+		GuestID: owner.GetGuestId(),
+	}
+}
+
+func convertToProtoCart(view *models.CartView) *proto.Cart {
+	cart := &proto.Cart{
+		UserId:   view.Cart.UserID,
+		GuestId:  view.Cart.GuestID,
+		Subtotal: view.Subtotal,
+		Currency: view.Currency,
+		Ready:    view.Ready,
+	}
+	if !view.Cart.UpdatedAt.IsZero() {
+		cart.UpdatedAt = timestamppb.New(view.Cart.UpdatedAt)
+	}
+	for _, line := range view.Lines {
+		cart.Items = append(cart.Items, &proto.CartItem{
+			ProductId:      line.ProductID,
+			OfferId:        line.OfferID,
+			Quantity:       int32(line.Quantity),
+			Name:           line.Name,
+			Supplier:       line.Supplier,
+			UnitPrice:      line.UnitPrice,
+			AddedUnitPrice: line.AddedUnitPrice,
+			LineTotal:      line.LineTotal,
+			Problem:        line.Problem,
+			AddedAt:        timestamppb.New(line.AddedAt),
+		})
+	}
+	return cart
+}
@@ -0,0 +1,54 @@
+package delivery
+
+import (
+	"errors"
+	"order-service/internal/models"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError - преобразование ошибки бизнес-логики в gRPC-статус
+func toStatusError(err error, msg string) error {
+	var validationErr *models.ValidationError
+	var transitionErr *models.TransitionError
+	switch {
+	case errors.As(err, &validationErr):
+		return validationStatus(validationErr)
+	case errors.Is(err, models.ErrNotFound), errors.Is(err, models.ErrCartItemNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.As(err, &transitionErr):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, models.ErrCartEmpty), errors.Is(err, models.ErrCartTotalChanged),
+		errors.Is(err, models.ErrOutOfStock), errors.Is(err, models.ErrReservationExpired),
+		errors.Is(err, models.ErrPaymentDeclined), errors.Is(err, models.ErrSagaInProgress):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, models.ErrStatusConflict), errors.Is(err, models.ErrCartConflict):
+		return status.Errorf(codes.Aborted, "%s: %v", msg, err)
+	case errors.Is(err, models.ErrForbidden):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, models.ErrCatalogUnavailable), errors.Is(err, models.ErrPaymentUnavailable):
+		return status.Errorf(codes.Unavailable, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// validationStatus - статус InvalidArgument с перечнем нарушений в errdetails.BadRequest
+func validationStatus(err *models.ValidationError) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range err.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	detailed, detailsErr := st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
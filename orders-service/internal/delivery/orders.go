@@ -6,10 +6,7 @@ import (
 	"order-service/internal/proto"
 	"order-service/internal/usecase"
 
-	"go.mongodb.org/mongo-driver/bson"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -28,43 +25,43 @@ func NewOrderHandler(service *usecase.OrderService, logger *zap.Logger) *OrderHa
 func (h *OrderHandler) CreateOrder(ctx context.Context, req *proto.CreateOrderRequest) (*proto.CreateOrderResponse, error) {
 	h.logger.Info("Создание заказа", zap.String("user_id", req.UserId))
 
-	order := &models.Order{
-		UserID:     req.UserId,
-		ProductIDs: req.ProductIds,
-		Status:     "pending",
+	order := &models.Order{UserID: req.UserId}
+	for _, item := range req.Items {
+		order.Items = append(order.Items, models.OrderItem{
+			ProductID: item.ProductId,
+			OfferID:   item.OfferId,
+			Quantity:  int(item.Quantity),
+		})
 	}
 
-	savedOrder, err := h.service.Create(ctx, order)
+	savedOrder, err := h.service.Create(ctx, order, requesterFromProto(req.Requester))
 	if err != nil {
 		h.logger.Error("Ошибка создания заказа", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось создать заказ: %v", err)
+		return nil, toStatusError(err, "не удалось создать заказ")
 	}
 
-	return &proto.CreateOrderResponse{
-		Order: &proto.Order{
-			Id:         savedOrder.ID,
-			UserId:     savedOrder.UserID,
-			ProductIds: savedOrder.ProductIDs,
-			TotalPrice: savedOrder.TotalPrice,
-			Status:     savedOrder.Status,
-			CreatedAt:  timestamppb.New(savedOrder.CreatedAt),
-		},
-	}, nil
+	return &proto.CreateOrderResponse{Order: convertToProtoOrder(savedOrder)}, nil
 }
 
-func (h *OrderHandler) ListOrders(ctx context.Context, req *proto.ListOrdersRequest) (*proto.ListOrdersResponse, error) {
-	h.logger.Info("Получение списка заказов", zap.String("user_id", req.UserId))
+func (h *OrderHandler) GetOrder(ctx context.Context, req *proto.GetOrderRequest) (*proto.GetOrderResponse, error) {
+	h.logger.Info("Получение заказа", zap.String("order_id", req.OrderId))
 
-	filter := bson.M{
-		"user_id": req.UserId,
+	order, err := h.service.GetByID(ctx, req.OrderId, requesterFromProto(req.Requester))
+	if err != nil {
+		h.logger.Error("Ошибка при получении заказа", zap.String("order_id", req.OrderId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось получить заказ")
 	}
-	limit := req.Limit
-	offset := req.Offset
 
-	orders, err := h.service.List(ctx, filter, int(limit), int(offset))
+	return &proto.GetOrderResponse{Order: convertToProtoOrder(order)}, nil
+}
+
+func (h *OrderHandler) ListOrders(ctx context.Context, req *proto.ListOrdersRequest) (*proto.ListOrdersResponse, error) {
+	h.logger.Info("Получение списка заказов", zap.String("user_id", req.UserId))
+
+	orders, nextCursor, err := h.service.List(ctx, req.UserId, requesterFromProto(req.Requester), int(req.Limit), int(req.Offset), req.Cursor)
 	if err != nil {
 		h.logger.Error("Ошибка при получении заказов", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось получить заказы: %v", err)
+		return nil, toStatusError(err, "не удалось получить заказы")
 	}
 
 	var protoOrders []*proto.Order
@@ -72,44 +69,91 @@ func (h *OrderHandler) ListOrders(ctx context.Context, req *proto.ListOrdersRequ
 		protoOrders = append(protoOrders, convertToProtoOrder(&order))
 	}
 
-	return &proto.ListOrdersResponse{Orders: protoOrders}, nil
+	return &proto.ListOrdersResponse{Orders: protoOrders, NextCursor: nextCursor}, nil
 }
 
 func (h *OrderHandler) UpdateOrderStatus(ctx context.Context, req *proto.UpdateOrderStatusRequest) (*proto.UpdateOrderStatusResponse, error) {
 	h.logger.Info("Обновление статуса заказа", zap.String("order_id", req.OrderId), zap.String("status", req.Status))
 
-	order := &models.Order{
-		ID:     req.OrderId,
-		Status: req.Status,
+	order, err := h.service.UpdateOrderStatus(ctx, req.OrderId, req.Status, req.Comment, requesterFromProto(req.Requester))
+	if err != nil {
+		h.logger.Error("Ошибка обновления статуса", zap.Error(err))
+		return nil, toStatusError(err, "не удалось обновить статус")
 	}
 
-	_, err := h.service.UpdateOrderStatus(ctx, order)
+	return &proto.UpdateOrderStatusResponse{Success: true, Order: convertToProtoOrder(order)}, nil
+}
+
+// GetOrderHistory - история статусов заказа
+func (h *OrderHandler) GetOrderHistory(ctx context.Context, req *proto.GetOrderHistoryRequest) (*proto.GetOrderHistoryResponse, error) {
+	h.logger.Info("Получение истории статусов заказа", zap.String("order_id", req.OrderId))
+
+	history, err := h.service.History(ctx, req.OrderId, requesterFromProto(req.Requester))
 	if err != nil {
-		h.logger.Error("Ошибка обновления статуса", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось обновить статус: %v", err)
+		h.logger.Error("Ошибка при получении истории статусов", zap.String("order_id", req.OrderId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось получить историю статусов")
 	}
 
-	return &proto.UpdateOrderStatusResponse{Success: true}, nil
+	resp := &proto.GetOrderHistoryResponse{}
+	for _, change := range history {
+		resp.History = append(resp.History, &proto.StatusChange{
+			From:      change.From,
+			To:        change.To,
+			ActorId:   change.ActorID,
+			ActorRole: change.ActorRole,
+			Comment:   change.Comment,
+			ChangedAt: timestamppb.New(change.ChangedAt),
+		})
+	}
+	return resp, nil
 }
 
 func (h *OrderHandler) DeleteOrder(ctx context.Context, req *proto.DeleteOrderRequest) (*proto.DeleteOrderResponse, error) {
 	h.logger.Info("Удаление заказа", zap.String("order_id", req.OrderId))
 
-	if err := h.service.Delete(ctx, req.OrderId); err != nil {
+	if err := h.service.Delete(ctx, req.OrderId, requesterFromProto(req.Requester)); err != nil {
 		h.logger.Error("Ошибка удаления заказа", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось удалить заказ: %v", err)
+		return nil, toStatusError(err, "не удалось удалить заказ")
 	}
 
 	return &proto.DeleteOrderResponse{Success: true}, nil
 }
 
+// requesterFromProto - преобразование данных пользователя из запроса.
+// Отсутствие пользователя означает анонимный запрос без доступа к заказам.
+func requesterFromProto(r *proto.Requester) models.Requester {
+	return models.Requester{
+		UserID: r.GetUserId(),
+		Role:   r.GetRole(),
+	}
+}
+
 func convertToProtoOrder(order *models.Order) *proto.Order {
-	return &proto.Order{
+	protoOrder := &proto.Order{
 		Id:         order.ID,
 		UserId:     order.UserID,
-		ProductIds: order.ProductIDs,
+		Subtotal:   order.Subtotal,
 		TotalPrice: order.TotalPrice,
+		Currency:   order.Currency,
 		Status:     order.Status,
 		CreatedAt:  timestamppb.New(order.CreatedAt),
 	}
+	if !order.UpdatedAt.IsZero() {
+		protoOrder.UpdatedAt = timestamppb.New(order.UpdatedAt)
+	}
+	if !order.ReservedUntil.IsZero() {
+		protoOrder.ReservedUntil = timestamppb.New(order.ReservedUntil)
+	}
+	for _, item := range order.Items {
+		protoOrder.Items = append(protoOrder.Items, &proto.OrderItem{
+			ProductId: item.ProductID,
+			OfferId:   item.OfferID,
+			Supplier:  item.Supplier,
+			Name:      item.Name,
+			Quantity:  int32(item.Quantity),
+			UnitPrice: item.UnitPrice,
+			LineTotal: item.LineTotal,
+		})
+	}
+	return protoOrder
 }
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrCartItemNotFound - позиции нет в корзине
+var ErrCartItemNotFound = errors.New("позиция не найдена в корзине")
+
+// ErrCartEmpty - оформление заказа из пустой корзины
+var ErrCartEmpty = errors.New("корзина пуста")
+
+// ErrCartTotalChanged - сумма корзины изменилась с момента, когда ее видел покупатель
+var ErrCartTotalChanged = errors.New("сумма корзины изменилась, проверьте цены")
+
+// ErrCartConflict - корзина изменилась параллельно или по ней оформляется заказ
+var ErrCartConflict = errors.New("корзина изменилась, повторите запрос")
+
+// CartOwner - владелец корзины: авторизованный пользователь или гость
+type CartOwner struct {
+	UserID string
+	// GuestID - идентификатор гостевой корзины, используется без UserID
+	GuestID string
+}
+
+// Cart - корзина покупателя
+type Cart struct {
+	ID      string     `bson:"_id"`
+	UserID  string     `bson:"user_id,omitempty"`
+	GuestID string     `bson:"guest_id,omitempty"`
+	Items   []CartItem `bson:"items"`
+	// Version - номер версии для оптимистичной блокировки, растет с каждым сохранением
+	Version int64 `bson:"version"`
+	// CheckoutOrderID - заказ, который оформляется из корзины; пока он задан, корзина не изменяется
+	CheckoutOrderID   string    `bson:"checkout_order_id,omitempty"`
+	CheckoutStartedAt time.Time `bson:"checkout_started_at,omitempty"`
+	// MergedGuests - перенесенные в корзину пользователя гостевые корзины, повторный перенос которых пропускается
+	MergedGuests []MergedGuest `bson:"merged_guests,omitempty"`
+	UpdatedAt    time.Time     `bson:"updated_at"`
+}
+
+// MergedGuest - отметка о переносе гостевой корзины в состоянии на момент UpdatedAt
+type MergedGuest struct {
+	CartID    string    `bson:"cart_id"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// CartItem - позиция корзины
+type CartItem struct {
+	ProductID string `bson:"product_id"`
+	OfferID   string `bson:"offer_id,omitempty"`
+	Quantity  int    `bson:"quantity"`
+	// AddedUnitPrice - цена за единицу, которую покупатель видел при добавлении позиции
+	AddedUnitPrice float64   `bson:"added_unit_price"`
+	AddedAt        time.Time `bson:"added_at"`
+}
+
+// Find - индекс позиции по продукту и предложению, -1 если позиции нет
+func (c *Cart) Find(productID, offerID string) int {
+	for i, item := range c.Items {
+		if item.ProductID == productID && item.OfferID == offerID {
+			return i
+		}
+	}
+	return -1
+}
+
+// Merged - гостевая корзина в этом состоянии уже перенесена в корзину
+func (c *Cart) Merged(guest *Cart) bool {
+	for _, m := range c.MergedGuests {
+		if m.CartID == guest.ID && m.UpdatedAt.Equal(guest.UpdatedAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// CartView - корзина с позициями по текущим ценам каталога
+type CartView struct {
+	Cart  *Cart
+	Lines []CartLine
+	// Subtotal - сумма позиций, которые можно заказать
+	Subtotal float64
+	Currency string
+	// Ready - корзина не пуста и все позиции можно заказать
+	Ready bool
+}
+
+// CartLine - позиция корзины по текущим ценам
+type CartLine struct {
+	CartItem
+	Name      string
+	Supplier  string
+	UnitPrice float64
+	LineTotal float64
+	// Problem - причина, по которой позицию нельзя заказать, пустая - позиция доступна
+	Problem string
+}
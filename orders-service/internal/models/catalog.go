@@ -0,0 +1,47 @@
+package models
+
+import "errors"
+
+// ErrProductNotFound - продукт отсутствует в каталоге
+var ErrProductNotFound = errors.New("продукт не найден")
+
+// ErrCatalogUnavailable - сервис продуктов недоступен, цены позиций определить нельзя
+var ErrCatalogUnavailable = errors.New("сервис продуктов недоступен")
+
+// ErrOutOfStock - остатка предложений не хватает для резерва под заказ
+var ErrOutOfStock = errors.New("недостаточно товара в наличии")
+
+// ErrReservationNotFound - в сервисе продуктов нет резерва для заказа
+var ErrReservationNotFound = errors.New("резерв товаров по заказу не найден")
+
+// ErrReservationExpired - резерв заказа снят или истек, оплатить заказ нельзя
+var ErrReservationExpired = errors.New("резерв товаров по заказу истек")
+
+// CatalogProduct - продукт каталога с текущими ценами
+type CatalogProduct struct {
+	ID    string
+	Name  string
+	Price float64
+	// Offers - все предложения поставщиков, включая отсутствующие в наличии
+	Offers []CatalogOffer
+}
+
+// CatalogOffer - предложение поставщика по продукту
+type CatalogOffer struct {
+	ID       string
+	Supplier string
+	Price    float64
+	Currency string
+	// Quantity - доступное количество за вычетом резервов, 0 - нет в наличии
+	Quantity int
+}
+
+// Offer - предложение продукта по ID
+func (p *CatalogProduct) Offer(id string) (CatalogOffer, bool) {
+	for _, o := range p.Offers {
+		if o.ID == id {
+			return o, true
+		}
+	}
+	return CatalogOffer{}, false
+}
@@ -3,10 +3,33 @@ package models
 import "time"
 
 type Order struct {
-	ID         string    `bson:"_id,omitempty"`
-	UserID     string    `bson:"user_id"`
-	ProductIDs []string  `bson:"product_ids"`
+	ID     string      `bson:"_id,omitempty"`
+	UserID string      `bson:"user_id"`
+	Items  []OrderItem `bson:"items"`
+	// Subtotal - сумма позиций, TotalPrice - итоговая сумма заказа
+	Subtotal   float64   `bson:"subtotal"`
 	TotalPrice float64   `bson:"total_price"`
+	Currency   string    `bson:"currency"`
 	Status     string    `bson:"status"`
 	CreatedAt  time.Time `bson:"created_at"`
+	UpdatedAt  time.Time `bson:"updated_at"`
+	// ReservedUntil - срок резерва остатков под заказ, нулевой - позиции без предложений не резервируются
+	ReservedUntil time.Time `bson:"reserved_until,omitempty"`
+	// PaymentID - авторизованный платеж, списывается при оплате и отменяется при отмене заказа
+	PaymentID string `bson:"payment_id,omitempty"`
+	// StatusHistory - изменения статуса в хронологическом порядке, начиная с создания заказа
+	StatusHistory []StatusChange `bson:"status_history"`
+}
+
+// OrderItem - позиция заказа. Название и цена сохраняются на момент оформления
+// и не меняются вместе с каталогом.
+type OrderItem struct {
+	ProductID string `bson:"product_id"`
+	// OfferID - предложение поставщика, пустое - цена из каталога
+	OfferID   string  `bson:"offer_id,omitempty"`
+	Supplier  string  `bson:"supplier,omitempty"`
+	Name      string  `bson:"name"`
+	Quantity  int     `bson:"quantity"`
+	UnitPrice float64 `bson:"unit_price"`
+	LineTotal float64 `bson:"line_total"`
 }
@@ -0,0 +1,29 @@
+package models
+
+// Роли пользователей, которым доступны все заказы
+const (
+	RoleAdmin   = "admin"
+	RoleManager = "manager"
+)
+
+// Requester - пользователь, от имени которого выполняется операция
+type Requester struct {
+	UserID string
+	Role   string
+}
+
+// IsStaff - проверка, что пользователь является сотрудником и видит чужие заказы
+func (r Requester) IsStaff() bool {
+	return r.Role == RoleAdmin || r.Role == RoleManager
+}
+
+// CanAccess - проверка, что пользователю доступен заказ
+func (r Requester) CanAccess(order *Order) bool {
+	return r.IsStaff() || (r.UserID != "" && order.UserID == r.UserID)
+}
+
+// CanSetStatus - проверка, что пользователь может перевести свой заказ в статус.
+// Покупатель может только отменить заказ, остальные переходы выполняют сотрудники.
+func (r Requester) CanSetStatus(status string) bool {
+	return r.IsStaff() || status == StatusCancelled
+}
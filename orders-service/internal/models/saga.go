@@ -0,0 +1,104 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrSagaLockLost - сага захвачена другим обработчиком, текущий обработчик должен остановиться
+var ErrSagaLockLost = errors.New("сага обрабатывается другим обработчиком")
+
+// ErrSagaInProgress - заказ еще оформляется, до завершения саги его можно только отменить
+var ErrSagaInProgress = errors.New("заказ еще оформляется")
+
+// ErrPaymentDeclined - платеж отклонен, повтор не поможет
+var ErrPaymentDeclined = errors.New("платеж отклонен")
+
+// ErrPaymentUnavailable - платежный сервис временно недоступен
+var ErrPaymentUnavailable = errors.New("платежный сервис недоступен")
+
+// Состояния саги оформления заказа
+const (
+	// SagaRunning - шаги выполняются
+	SagaRunning = "running"
+	// SagaCompensating - шаг завершился ошибкой, выполненные шаги отменяются в обратном порядке
+	SagaCompensating = "compensating"
+	// SagaCompleted - все шаги выполнены, заказ подтвержден
+	SagaCompleted = "completed"
+	// SagaCompensated - выполненные шаги отменены, заказ отменен
+	SagaCompensated = "compensated"
+	// SagaFailed - компенсация не удалась, нужен разбор вручную
+	SagaFailed = "failed"
+)
+
+// Шаги саги оформления заказа в порядке выполнения
+const (
+	StepReserveStock     = "reserve_stock"
+	StepAuthorizePayment = "authorize_payment"
+	StepConfirmOrder     = "confirm_order"
+)
+
+// Состояния шага саги
+const (
+	StepPending     = "pending"
+	StepDone        = "done"
+	StepCompensated = "compensated"
+)
+
+// Saga - состояние оформления заказа, сохраняется после каждого шага
+// и позволяет продолжить оформление после перезапуска сервиса
+type Saga struct {
+	// ID - ID заказа
+	ID     string     `bson:"_id"`
+	Status string     `bson:"status"`
+	Steps  []SagaStep `bson:"steps"`
+	// Error - причина компенсации
+	Error     string `bson:"error,omitempty"`
+	PaymentID string `bson:"payment_id,omitempty"`
+	// NextAttemptAt - время следующей попытки после временной ошибки
+	NextAttemptAt time.Time `bson:"next_attempt_at"`
+	// LockToken и LockedUntil - аренда саги обработчиком, по истечении сагу может продолжить другой обработчик
+	LockToken   string    `bson:"lock_token,omitempty"`
+	LockedUntil time.Time `bson:"locked_until"`
+	CreatedAt   time.Time `bson:"created_at"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+}
+
+// SagaStep - шаг саги
+type SagaStep struct {
+	Name   string `bson:"name"`
+	Status string `bson:"status"`
+	// Attempts - попытки выполнения шага, на компенсации - попытки компенсации
+	Attempts  int       `bson:"attempts"`
+	LastError string    `bson:"last_error,omitempty"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// NewSaga - сага заказа со всеми шагами в ожидании
+func NewSaga(orderID string, now time.Time) *Saga {
+	saga := &Saga{ID: orderID, Status: SagaRunning, NextAttemptAt: now, CreatedAt: now, UpdatedAt: now}
+	for _, name := range []string{StepReserveStock, StepAuthorizePayment, StepConfirmOrder} {
+		saga.Steps = append(saga.Steps, SagaStep{Name: name, Status: StepPending, UpdatedAt: now})
+	}
+	return saga
+}
+
+// NextStep - первый невыполненный шаг, nil - все шаги выполнены
+func (s *Saga) NextStep() *SagaStep {
+	for i := range s.Steps {
+		if s.Steps[i].Status == StepPending {
+			return &s.Steps[i]
+		}
+	}
+	return nil
+}
+
+// NextCompensation - последний выполненный и еще не отмененный шаг, nil - отменять нечего
+func (s *Saga) NextCompensation() *SagaStep {
+	for i := len(s.Steps) - 1; i >= 0; i-- {
+		if s.Steps[i].Status == StepDone {
+			return &s.Steps[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Статусы заказа
+const (
+	StatusPending   = "pending"
+	StatusConfirmed = "confirmed"
+	StatusPaid      = "paid"
+	StatusShipped   = "shipped"
+	StatusDelivered = "delivered"
+	StatusCancelled = "cancelled"
+	StatusRefunded  = "refunded"
+)
+
+// transitions - допустимые переходы между статусами.
+// Отмена возможна до оплаты, после оплаты деньги возвращаются через refunded.
+var transitions = map[string][]string{
+	StatusPending:   {StatusConfirmed, StatusCancelled},
+	StatusConfirmed: {StatusPaid, StatusCancelled},
+	StatusPaid:      {StatusShipped, StatusRefunded},
+	StatusShipped:   {StatusDelivered},
+	StatusDelivered: {StatusRefunded},
+	StatusCancelled: nil,
+	StatusRefunded:  nil,
+}
+
+// IsKnownStatus - проверка, что статус входит в жизненный цикл заказа
+func IsKnownStatus(status string) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+// CanTransition - проверка допустимости перехода из статуса from в статус to
+func CanTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionError - недопустимый переход статуса заказа
+type TransitionError struct {
+	From string
+	To   string
+}
+
+func (e *TransitionError) Error() string {
+	if e.From == e.To {
+		return fmt.Sprintf("заказ уже в статусе %s", e.To)
+	}
+	return fmt.Sprintf("переход из статуса %s в статус %s недопустим", e.From, e.To)
+}
+
+// StatusChange - запись истории статусов заказа
+type StatusChange struct {
+	// From - предыдущий статус, пустой для создания заказа
+	From      string    `bson:"from,omitempty"`
+	To        string    `bson:"to"`
+	ActorID   string    `bson:"actor_id"`
+	ActorRole string    `bson:"actor_role,omitempty"`
+	Comment   string    `bson:"comment,omitempty"`
+	ChangedAt time.Time `bson:"changed_at"`
+}
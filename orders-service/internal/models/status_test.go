@@ -0,0 +1,33 @@
+package models
+
+import "testing"
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{StatusPending, StatusConfirmed, true},
+		{StatusPending, StatusCancelled, true},
+		{StatusPending, StatusPaid, false},
+		{StatusConfirmed, StatusPaid, true},
+		{StatusConfirmed, StatusCancelled, true},
+		{StatusConfirmed, StatusShipped, false},
+		{StatusPaid, StatusShipped, true},
+		{StatusPaid, StatusRefunded, true},
+		{StatusPaid, StatusCancelled, false},
+		{StatusShipped, StatusDelivered, true},
+		{StatusShipped, StatusRefunded, false},
+		{StatusDelivered, StatusRefunded, true},
+		{StatusCancelled, StatusPending, false},
+		{StatusRefunded, StatusPaid, false},
+		{StatusPending, StatusPending, false},
+		{"unknown", StatusConfirmed, false},
+		{StatusPending, "unknown", false},
+	}
+	for _, tt := range tests {
+		if got := CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransition(%q, %q) = %v, ожидалось %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidCursor - курсор поврежден, подделан или выдан для другого запроса
+var ErrInvalidCursor = errors.New("некорректный курсор")
+
+// CursorSigner - кодирование позиции keyset-пагинации в непрозрачный курсор, подписанный HMAC-SHA256
+type CursorSigner struct {
+	secret []byte
+}
+
+// NewCursorSigner - конструктор подписи курсоров
+func NewCursorSigner(secret []byte) *CursorSigner {
+	return &CursorSigner{secret: secret}
+}
+
+// RandomSecret - случайный секрет для подписи курсоров, если он не задан в конфигурации.
+// Курсоры, выданные до перезапуска сервиса, при этом перестают действовать.
+func RandomSecret() ([]byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// Encode - кодирование позиции в курсор вида <payload>.<signature>
+func (s *CursorSigner) Encode(position any) (string, error) {
+	payload, err := json.Marshal(position)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.sign(payload)), nil
+}
+
+// Decode - проверка подписи курсора и декодирование позиции
+func (s *CursorSigner) Decode(cursor string, position any) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return ErrInvalidCursor
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if !hmac.Equal(signature, s.sign(payload)) {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(payload, position); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
+
+func (s *CursorSigner) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
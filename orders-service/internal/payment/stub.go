@@ -0,0 +1,104 @@
+// Package payment - платежный шаг оформления заказа
+package payment
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"order-service/internal/models"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Состояния платежа заглушки
+const (
+	statusAuthorized = "authorized"
+	statusCaptured   = "captured"
+	statusVoided     = "voided"
+)
+
+// Stub - заглушка платежного сервиса: платежи хранятся в памяти процесса.
+// ID платежа выводится из ID заказа, поэтому повторная авторизация того же заказа,
+// в том числе после перезапуска, возвращает тот же платеж.
+type Stub struct {
+	// declineAbove - сумма, выше которой платеж отклоняется, 0 - без ограничения
+	declineAbove float64
+	// failureRate - доля запросов, завершающихся временной ошибкой
+	failureRate float64
+	logger      *zap.Logger
+
+	mu       sync.Mutex
+	payments map[string]string
+}
+
+// NewStub - конструктор заглушки платежного сервиса
+func NewStub(declineAbove, failureRate float64, logger *zap.Logger) *Stub {
+	return &Stub{
+		declineAbove: declineAbove,
+		failureRate:  failureRate,
+		logger:       logger,
+		payments:     make(map[string]string),
+	}
+}
+
+// Authorize - блокировка суммы заказа. Возвращает ID платежа,
+// models.ErrPaymentDeclined при отказе и models.ErrPaymentUnavailable при временной ошибке.
+func (s *Stub) Authorize(ctx context.Context, orderID string, amount float64, currency string) (string, error) {
+	if err := s.unavailable(); err != nil {
+		return "", err
+	}
+	if s.declineAbove > 0 && amount > s.declineAbove {
+		return "", fmt.Errorf("%w: сумма %.2f %s превышает лимит", models.ErrPaymentDeclined, amount, currency)
+	}
+
+	id := "stub-" + orderID
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.payments[id]; !ok {
+		s.payments[id] = statusAuthorized
+		s.logger.Info("Платеж авторизован", zap.String("payment_id", id), zap.Float64("amount", amount), zap.String("currency", currency))
+	}
+	return id, nil
+}
+
+// Capture - списание авторизованной суммы. Отмененный платеж списать нельзя.
+func (s *Stub) Capture(ctx context.Context, paymentID string) error {
+	if err := s.unavailable(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.payments[paymentID] == statusVoided {
+		return fmt.Errorf("%w: платеж %s отменен", models.ErrPaymentDeclined, paymentID)
+	}
+	s.payments[paymentID] = statusCaptured
+	return nil
+}
+
+// Void - отмена авторизации. Повторная отмена и отмена неизвестного платежа не считаются ошибкой.
+func (s *Stub) Void(ctx context.Context, paymentID string) error {
+	if err := s.unavailable(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.payments[paymentID] == statusCaptured {
+		return fmt.Errorf("%w: платеж %s уже списан", models.ErrPaymentDeclined, paymentID)
+	}
+	if s.payments[paymentID] != statusVoided {
+		s.payments[paymentID] = statusVoided
+		s.logger.Info("Авторизация платежа отменена", zap.String("payment_id", paymentID))
+	}
+	return nil
+}
+
+// unavailable - имитация временной недоступности платежного сервиса
+func (s *Stub) unavailable() error {
+	if s.failureRate > 0 && rand.Float64() < s.failureRate {
+		return models.ErrPaymentUnavailable
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// guestCartTTL - время хранения гостевой корзины после последнего изменения
+const guestCartTTL = 30 * 24 * time.Hour
+
+// CartRepository - репозиторий корзин в MongoDB
+type CartRepository struct {
+	collection *mongo.Collection
+}
+
+// NewCartRepository - конструктор репозитория корзин
+func NewCartRepository(db *mongo.Database) *CartRepository {
+	return &CartRepository{
+		collection: db.Collection("carts"),
+	}
+}
+
+// EnsureIndexes - создание индексов коллекции.
+// Гостевые корзины удаляются MongoDB через guestCartTTL после последнего изменения, корзины пользователей хранятся бессрочно.
+func (r *CartRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "updated_at", Value: 1}},
+		Options: options.Index().
+			SetExpireAfterSeconds(int32(guestCartTTL.Seconds())).
+			SetPartialFilterExpression(bson.M{"guest_id": bson.M{"$exists": true}}),
+	})
+	return err
+}
+
+// GetByID - получение корзины по ID
+func (r *CartRepository) GetByID(ctx context.Context, id string) (*models.Cart, error) {
+	var cart models.Cart
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&cart)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &cart, nil
+}
+
+// Save - сохранение корзины, если с момента чтения она не изменилась.
+// Новая корзина (Version 0) создается. Если корзину изменили параллельно, возвращается models.ErrCartConflict.
+func (r *CartRepository) Save(ctx context.Context, cart *models.Cart) error {
+	next := *cart
+	next.Version = cart.Version + 1
+
+	filter := bson.M{"_id": cart.ID, "version": cart.Version}
+	_, err := r.collection.ReplaceOne(ctx, filter, next, options.Replace().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		// Документ с таким _id существует, но с другой версией: upsert попытался создать второй
+		return models.ErrCartConflict
+	} else if err != nil {
+		return err
+	}
+	cart.Version = next.Version
+	return nil
+}
+
+// Delete - удаление корзины, если с момента чтения она не изменилась.
+// Измененная или уже удаленная корзина не удаляется и не считается ошибкой.
+func (r *CartRepository) Delete(ctx context.Context, id string, version int64) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id, "version": version})
+	return err
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"order-service/internal/models"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -34,7 +36,9 @@ func (r *OrderRepository) Create(ctx context.Context, order *models.Order) (*mod
 func (r *OrderRepository) GetByID(ctx context.Context, id string) (*models.Order, error) {
 	var order models.Order
 	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&order)
-	if err != nil {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrNotFound
+	} else if err != nil {
 		return nil, err
 	}
 	return &order, nil
@@ -47,7 +51,7 @@ func (r *OrderRepository) List(ctx context.Context, filter bson.M, limit, offset
 	findOptions := options.Find()
 	findOptions.SetLimit(int64(limit))
 	findOptions.SetSkip(int64(offset))
-	findOptions.SetSort(bson.M{"created_at": -1}) // Сортировка по дате создания (новые сначала)
+	findOptions.SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}) // Сортировка по дате создания (новые сначала)
 
 	cursor, err := r.collection.Find(ctx, filter, findOptions)
 	if err != nil {
@@ -70,12 +74,45 @@ func (r *OrderRepository) List(ctx context.Context, filter bson.M, limit, offset
 	return orders, nil
 }
 
-// Update - обновление существующего заказа
-func (r *OrderRepository) Update(ctx context.Context, order *models.Order) (*models.Order, error) {
-	filter := bson.M{"_id": order.ID}
-	update := bson.M{"$set": order}
-	_, err := r.collection.UpdateOne(ctx, filter, update)
-	return order, err
+// UpdateStatus - смена статуса заказа с записью в историю.
+// Статус меняется, только если заказ все еще в статусе change.From, иначе возвращается models.ErrStatusConflict.
+func (r *OrderRepository) UpdateStatus(ctx context.Context, id string, change models.StatusChange) (*models.Order, error) {
+	filter := bson.M{"_id": id, "status": change.From}
+	update := bson.M{
+		"$set":  bson.M{"status": change.To, "updated_at": change.ChangedAt},
+		"$push": bson.M{"status_history": change},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var order models.Order
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&order)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrStatusConflict
+	} else if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// SetReservation - сохранение срока резерва остатков заказа
+func (r *OrderRepository) SetReservation(ctx context.Context, id string, until time.Time) error {
+	return r.set(ctx, id, bson.M{"reserved_until": until})
+}
+
+// SetPayment - сохранение ID авторизованного платежа заказа
+func (r *OrderRepository) SetPayment(ctx context.Context, id, paymentID string) error {
+	return r.set(ctx, id, bson.M{"payment_id": paymentID})
+}
+
+func (r *OrderRepository) set(ctx context.Context, id string, fields bson.M) error {
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": fields})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+	return nil
 }
 
 // Delete - удаление заказа по ID
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SagaRepository - репозиторий саг оформления заказов в MongoDB
+type SagaRepository struct {
+	collection *mongo.Collection
+}
+
+// NewSagaRepository - конструктор репозитория саг
+func NewSagaRepository(db *mongo.Database) *SagaRepository {
+	return &SagaRepository{
+		collection: db.Collection("order_sagas"),
+	}
+}
+
+// EnsureIndexes - создание индексов коллекции: незавершенные саги выбираются по времени следующей попытки
+func (r *SagaRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+	})
+	return err
+}
+
+// Create - сохранение новой саги
+func (r *SagaRepository) Create(ctx context.Context, saga *models.Saga) error {
+	_, err := r.collection.InsertOne(ctx, saga)
+	return err
+}
+
+// GetByID - сага заказа, models.ErrNotFound - заказ оформлен без саги
+func (r *SagaRepository) GetByID(ctx context.Context, id string) (*models.Saga, error) {
+	var saga models.Saga
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&saga)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &saga, nil
+}
+
+// Save - сохранение состояния саги обработчиком, владеющим арендой token.
+// Если аренда перешла другому обработчику, возвращает models.ErrSagaLockLost.
+func (r *SagaRepository) Save(ctx context.Context, saga *models.Saga, token string) error {
+	res, err := r.collection.ReplaceOne(ctx, bson.M{"_id": saga.ID, "lock_token": token}, saga)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrSagaLockLost
+	}
+	return nil
+}
+
+// ClaimDue - захват незавершенной саги, время попытки которой наступило и аренда свободна или истекла.
+// Возвращает nil, если таких саг нет.
+func (r *SagaRepository) ClaimDue(ctx context.Context, now time.Time, token string, until time.Time) (*models.Saga, error) {
+	filter := bson.M{
+		"status":          bson.M{"$in": bson.A{models.SagaRunning, models.SagaCompensating}},
+		"next_attempt_at": bson.M{"$lte": now},
+		"locked_until":    bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"lock_token": token, "locked_until": until}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var saga models.Saga
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&saga)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &saga, nil
+}
@@ -0,0 +1,399 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"order-service/internal/catalog"
+	"order-service/internal/models"
+	"order-service/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Параметры работы с корзиной
+const (
+	// saveAttempts - число попыток изменить корзину при параллельных изменениях
+	saveAttempts = 3
+	// checkoutTimeout - время, после которого незавершенное оформление заказа из корзины отменяется
+	checkoutTimeout = time.Minute
+	// maxMergedGuests - число последних перенесенных гостевых корзин, хранимых в корзине пользователя
+	maxMergedGuests = 10
+)
+
+// CartService - сервис корзин покупателей и гостей
+type CartService struct {
+	carts   *repository.CartRepository
+	orders  *OrderService
+	catalog *catalog.ProductsCatalog
+}
+
+// NewCartService - конструктор сервиса корзин
+func NewCartService(carts *repository.CartRepository, orders *OrderService, catalog *catalog.ProductsCatalog) *CartService {
+	return &CartService{carts: carts, orders: orders, catalog: catalog}
+}
+
+// Get - корзина с позициями по текущим ценам каталога
+func (s *CartService) Get(ctx context.Context, owner models.CartOwner) (*models.CartView, error) {
+	cart, err := s.load(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	return s.view(ctx, cart)
+}
+
+// Add - добавление товара в корзину. Если позиция уже есть, количество увеличивается.
+// Продукт и предложение проверяются по каталогу, цена запоминается для сравнения с текущей.
+func (s *CartService) Add(ctx context.Context, owner models.CartOwner, item models.CartItem) (*models.CartView, error) {
+	verr := &models.ValidationError{}
+	validateCartItem(item, verr)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, owner, func(cart *models.Cart) error {
+		quantity := item.Quantity
+		i := cart.Find(item.ProductID, item.OfferID)
+		if i >= 0 {
+			quantity += cart.Items[i].Quantity
+		} else if len(cart.Items) >= maxOrderItems {
+			verr.Add("product_id", fmt.Sprintf("корзина может содержать не более %d позиций", maxOrderItems))
+			return verr
+		}
+		if quantity > maxItemQuantity {
+			verr.Add("quantity", fmt.Sprintf("количество должно быть от 1 до %d", maxItemQuantity))
+			return verr
+		}
+
+		quote, err := s.quote(ctx, item.ProductID, item.OfferID, quantity)
+		if err != nil {
+			return err
+		}
+		if i < 0 {
+			cart.Items = append(cart.Items, models.CartItem{
+				ProductID: item.ProductID,
+				OfferID:   item.OfferID,
+				AddedAt:   time.Now().UTC(),
+			})
+			i = len(cart.Items) - 1
+		}
+		cart.Items[i].Quantity = quantity
+		cart.Items[i].AddedUnitPrice = quote.UnitPrice
+		return nil
+	})
+}
+
+// SetQuantity - изменение количества позиции корзины
+func (s *CartService) SetQuantity(ctx context.Context, owner models.CartOwner, item models.CartItem) (*models.CartView, error) {
+	verr := &models.ValidationError{}
+	validateCartItem(item, verr)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, owner, func(cart *models.Cart) error {
+		i := cart.Find(item.ProductID, item.OfferID)
+		if i < 0 {
+			return models.ErrCartItemNotFound
+		}
+		cart.Items[i].Quantity = item.Quantity
+		return nil
+	})
+}
+
+// Remove - удаление позиции из корзины
+func (s *CartService) Remove(ctx context.Context, owner models.CartOwner, productID, offerID string) (*models.CartView, error) {
+	return s.update(ctx, owner, func(cart *models.Cart) error {
+		i := cart.Find(productID, offerID)
+		if i < 0 {
+			return models.ErrCartItemNotFound
+		}
+		cart.Items = append(cart.Items[:i], cart.Items[i+1:]...)
+		return nil
+	})
+}
+
+// Clear - удаление всех позиций из корзины
+func (s *CartService) Clear(ctx context.Context, owner models.CartOwner) (*models.CartView, error) {
+	return s.update(ctx, owner, func(cart *models.Cart) error {
+		cart.Items = nil
+		return nil
+	})
+}
+
+// Merge - перенос гостевой корзины в корзину пользователя после входа.
+// Количества совпадающих позиций складываются, после сохранения корзины пользователя гостевая корзина удаляется.
+func (s *CartService) Merge(ctx context.Context, requester models.Requester, guestID string) (*models.CartView, error) {
+	owner := models.CartOwner{UserID: requester.UserID}
+	if requester.UserID == "" {
+		verr := &models.ValidationError{}
+		verr.Add("requester", "корзину можно перенести только в корзину авторизованного пользователя")
+		return nil, verr
+	}
+	guestCartID, err := cartID(models.CartOwner{GuestID: guestID})
+	if err != nil {
+		return nil, err
+	}
+
+	guest, err := s.carts.GetByID(ctx, guestCartID)
+	if errors.Is(err, models.ErrNotFound) {
+		return s.Get(ctx, owner)
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Отметка о переносе сохраняется вместе с позициями, поэтому параллельные входы не перенесут корзину дважды
+	view, err := s.update(ctx, owner, func(cart *models.Cart) error {
+		if cart.Merged(guest) {
+			return nil
+		}
+		for _, item := range guest.Items {
+			if i := cart.Find(item.ProductID, item.OfferID); i >= 0 {
+				cart.Items[i].Quantity = min(cart.Items[i].Quantity+item.Quantity, maxItemQuantity)
+				continue
+			}
+			if len(cart.Items) < maxOrderItems {
+				cart.Items = append(cart.Items, item)
+			}
+		}
+		cart.MergedGuests = append(cart.MergedGuests, models.MergedGuest{CartID: guest.ID, UpdatedAt: guest.UpdatedAt})
+		if len(cart.MergedGuests) > maxMergedGuests {
+			cart.MergedGuests = cart.MergedGuests[len(cart.MergedGuests)-maxMergedGuests:]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Гостевая корзина удаляется только после сохранения корзины пользователя: при сбое товары остаются в ней,
+	// а повторный перенос пропускает уже перенесенное состояние
+	if err := s.carts.Delete(ctx, guest.ID, guest.Version); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// Checkout - оформление заказа из корзины пользователя.
+// Корзина закрепляется за будущим заказом, заказ создается по текущим ценам, после чего корзина очищается.
+// Если expectedTotal задан и не совпадает с суммой заказа, заказ не создается.
+func (s *CartService) Checkout(ctx context.Context, requester models.Requester, expectedTotal float64) (*models.Order, error) {
+	if requester.UserID == "" {
+		verr := &models.ValidationError{}
+		verr.Add("requester", "оформление заказа доступно только авторизованным пользователям")
+		return nil, verr
+	}
+
+	cart, err := s.load(ctx, models.CartOwner{UserID: requester.UserID})
+	if err != nil {
+		return nil, err
+	}
+	if len(cart.Items) == 0 {
+		return nil, models.ErrCartEmpty
+	}
+
+	order := &models.Order{ID: uuid.NewString(), UserID: requester.UserID}
+	for _, item := range cart.Items {
+		order.Items = append(order.Items, models.OrderItem{
+			ProductID: item.ProductID,
+			OfferID:   item.OfferID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	// Закрепление корзины: параллельные изменения и повторное оформление получат ErrCartConflict
+	cart.CheckoutOrderID = order.ID
+	cart.CheckoutStartedAt = time.Now().UTC()
+	if err := s.carts.Save(ctx, cart); err != nil {
+		return nil, err
+	}
+
+	created, err := s.placeOrder(ctx, order, requester, expectedTotal)
+	if err != nil {
+		cart.CheckoutOrderID = ""
+		cart.CheckoutStartedAt = time.Time{}
+		if releaseErr := s.carts.Save(ctx, cart); releaseErr != nil {
+			return nil, errors.Join(err, releaseErr)
+		}
+		return nil, err
+	}
+
+	// Если очистить корзину не удалось, это сделает следующее обращение к ней через resumeCheckout
+	_ = s.finishCheckout(ctx, cart)
+	return created, nil
+}
+
+// placeOrder - расчет и сохранение заказа из корзины
+func (s *CartService) placeOrder(ctx context.Context, order *models.Order, requester models.Requester, expectedTotal float64) (*models.Order, error) {
+	if err := s.orders.prepare(ctx, order); err != nil {
+		return nil, err
+	}
+	if expectedTotal > 0 && math.Abs(order.TotalPrice-expectedTotal) >= 0.005 {
+		return nil, models.ErrCartTotalChanged
+	}
+	return s.orders.startSaga(ctx, order, requester)
+}
+
+// finishCheckout - очистка корзины после создания заказа
+func (s *CartService) finishCheckout(ctx context.Context, cart *models.Cart) error {
+	cart.Items = nil
+	cart.CheckoutOrderID = ""
+	cart.CheckoutStartedAt = time.Time{}
+	cart.UpdatedAt = time.Now().UTC()
+	return s.carts.Save(ctx, cart)
+}
+
+// load - корзина владельца, отсутствующая корзина возвращается пустой.
+// Незавершенное оформление заказа доводится до конца или отменяется.
+func (s *CartService) load(ctx context.Context, owner models.CartOwner) (*models.Cart, error) {
+	id, err := cartID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := s.carts.GetByID(ctx, id)
+	if errors.Is(err, models.ErrNotFound) {
+		return &models.Cart{ID: id, UserID: owner.UserID, GuestID: owner.GuestID}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if cart.CheckoutOrderID != "" {
+		if err := s.resumeCheckout(ctx, cart); err != nil {
+			return nil, err
+		}
+	}
+	return cart, nil
+}
+
+// resumeCheckout - завершение оформления, прерванного между созданием заказа и очисткой корзины.
+// Если заказ создан, корзина очищается; если нет и время оформления истекло, корзина освобождается.
+func (s *CartService) resumeCheckout(ctx context.Context, cart *models.Cart) error {
+	_, err := s.orders.repo.GetByID(ctx, cart.CheckoutOrderID)
+	switch {
+	case err == nil:
+		return s.finishCheckout(ctx, cart)
+	case !errors.Is(err, models.ErrNotFound):
+		return err
+	case time.Since(cart.CheckoutStartedAt) < checkoutTimeout:
+		return models.ErrCartConflict
+	}
+
+	cart.CheckoutOrderID = ""
+	cart.CheckoutStartedAt = time.Time{}
+	return s.carts.Save(ctx, cart)
+}
+
+// update - изменение корзины с повтором при параллельных изменениях
+func (s *CartService) update(ctx context.Context, owner models.CartOwner, change func(cart *models.Cart) error) (*models.CartView, error) {
+	var err error
+	for attempt := 0; attempt < saveAttempts; attempt++ {
+		var cart *models.Cart
+		cart, err = s.load(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		if err = change(cart); err != nil {
+			return nil, err
+		}
+
+		cart.UpdatedAt = time.Now().UTC()
+		err = s.carts.Save(ctx, cart)
+		if err == nil {
+			return s.view(ctx, cart)
+		}
+		if !errors.Is(err, models.ErrCartConflict) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// quote - проверка позиции по каталогу перед добавлением в корзину
+func (s *CartService) quote(ctx context.Context, productID, offerID string, quantity int) (itemQuote, error) {
+	product, err := s.catalog.Product(ctx, productID)
+	if errors.Is(err, models.ErrProductNotFound) {
+		verr := &models.ValidationError{}
+		verr.Add("product_id", "продукт не найден")
+		return itemQuote{}, verr
+	} else if err != nil {
+		return itemQuote{}, err
+	}
+
+	quote, field, problem := quoteItem(product, offerID, quantity)
+	if problem != "" {
+		verr := &models.ValidationError{}
+		verr.Add(field, problem)
+		return itemQuote{}, verr
+	}
+	return quote, nil
+}
+
+// view - позиции корзины по текущим ценам каталога.
+// Позиции, которые нельзя заказать, остаются в корзине с описанием причины и не входят в сумму.
+func (s *CartService) view(ctx context.Context, cart *models.Cart) (*models.CartView, error) {
+	view := &models.CartView{Cart: cart, Ready: len(cart.Items) > 0}
+	products := newProductLookup(s.catalog)
+
+	for _, item := range cart.Items {
+		line := models.CartLine{CartItem: item}
+
+		product, err := products.get(ctx, item.ProductID)
+		if err != nil && !errors.Is(err, models.ErrProductNotFound) {
+			return nil, err
+		}
+		if err != nil {
+			line.Problem = "продукт больше не продается"
+		} else {
+			quote, _, problem := quoteItem(product, item.OfferID, item.Quantity)
+			line.Name = quote.Name
+			line.Supplier = quote.Supplier
+			line.Problem = problem
+			if problem == "" {
+				if view.Currency == "" {
+					view.Currency = quote.Currency
+				}
+				if quote.Currency != view.Currency {
+					line.Problem = fmt.Sprintf("валюта позиции %s отличается от валюты корзины %s", quote.Currency, view.Currency)
+				}
+			}
+			if line.Problem == "" {
+				line.UnitPrice = quote.UnitPrice
+				line.LineTotal = roundPrice(quote.UnitPrice * float64(item.Quantity))
+				view.Subtotal += line.LineTotal
+			}
+		}
+
+		if line.Problem != "" {
+			view.Ready = false
+		}
+		view.Lines = append(view.Lines, line)
+	}
+
+	view.Subtotal = roundPrice(view.Subtotal)
+	return view, nil
+}
+
+// validateCartItem - проверка позиции, добавляемой в корзину
+func validateCartItem(item models.CartItem, verr *models.ValidationError) {
+	if item.ProductID == "" {
+		verr.Add("product_id", "не указан продукт")
+	}
+	if item.Quantity < 1 || item.Quantity > maxItemQuantity {
+		verr.Add("quantity", fmt.Sprintf("количество должно быть от 1 до %d", maxItemQuantity))
+	}
+}
+
+// cartID - ID корзины владельца: корзина пользователя или гостевая корзина
+func cartID(owner models.CartOwner) (string, error) {
+	if owner.UserID != "" {
+		return "user:" + owner.UserID, nil
+	}
+	if _, err := uuid.Parse(owner.GuestID); err != nil {
+		verr := &models.ValidationError{}
+		verr.Add("guest_id", "не указан или некорректен идентификатор гостевой корзины")
+		return "", verr
+	}
+	return "guest:" + owner.GuestID, nil
+}
@@ -3,62 +3,309 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"order-service/internal/catalog"
 	"order-service/internal/models"
+	"order-service/internal/pagination"
+	"order-service/internal/payment"
 	"order-service/internal/repository"
+	"time"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
+// Ограничения размера страницы списка заказов
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
 // OrderService - сервис для работы с продуктами
 type OrderService struct {
-	repo *repository.OrderRepository
+	repo     *repository.OrderRepository
+	sagas    *repository.SagaRepository
+	catalog  *catalog.ProductsCatalog
+	payments *payment.Stub
+	cursors  *pagination.CursorSigner
 }
 
 // NewOrderService - конструктор для создания сервиса
-func NewOrderService(repo *repository.OrderRepository) *OrderService {
-	return &OrderService{repo: repo}
+func NewOrderService(repo *repository.OrderRepository, sagas *repository.SagaRepository, catalog *catalog.ProductsCatalog, payments *payment.Stub, cursors *pagination.CursorSigner) *OrderService {
+	return &OrderService{repo: repo, sagas: sagas, catalog: catalog, payments: payments, cursors: cursors}
+}
+
+// orderCursor - позиция keyset-пагинации заказов
+type orderCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"id"`
+	// UserID - фильтр, для которого выдан курсор
+	UserID string `json:"u,omitempty"`
+}
+
+// Create - создание и оформление нового заказа.
+// Цены позиций и сумма заказа рассчитываются по текущему каталогу сервиса продуктов,
+// затем сага резервирует остатки, авторизует платеж и подтверждает заказ.
+func (s *OrderService) Create(ctx context.Context, Order *models.Order, requester models.Requester) (*models.Order, error) {
+	if err := s.prepare(ctx, Order); err != nil {
+		return nil, err
+	}
+	return s.startSaga(ctx, Order, requester)
+}
+
+// prepare - проверка нового заказа и расчет цен позиций
+func (s *OrderService) prepare(ctx context.Context, Order *models.Order) error {
+	verr := &models.ValidationError{}
+	if Order.UserID == "" {
+		verr.Add("user_id", "не указан покупатель")
+	}
+	validateItems(Order.Items, verr)
+	if err := verr.Err(); err != nil {
+		return err
+	}
+	return s.priceItems(ctx, Order)
 }
 
-// Create - создание нового продукта
-func (s *OrderService) Create(ctx context.Context, Order *models.Order) (*models.Order, error) {
-	// Генерация уникального ID для продукта
-	Order.ID = uuid.NewString()
+// insert - сохранение подготовленного заказа в статусе pending.
+// ID заказа генерируется, если не был назначен заранее.
+func (s *OrderService) insert(ctx context.Context, Order *models.Order, requester models.Requester) (*models.Order, error) {
+	if Order.ID == "" {
+		Order.ID = uuid.NewString()
+	}
+	Order.CreatedAt = time.Now().UTC()
+	Order.UpdatedAt = Order.CreatedAt
+	Order.Status = models.StatusPending
+	Order.StatusHistory = []models.StatusChange{{
+		To:        models.StatusPending,
+		ActorID:   requester.UserID,
+		ActorRole: requester.Role,
+		ChangedAt: Order.CreatedAt,
+	}}
 	return s.repo.Create(ctx, Order)
 }
 
-// GetByID - получение продукта по ID
-func (s *OrderService) GetByID(ctx context.Context, id string) (*models.Order, error) {
-	return s.repo.GetByID(ctx, id)
+// GetByID - получение заказа по ID.
+// Чужой заказ для покупателя неотличим от несуществующего.
+func (s *OrderService) GetByID(ctx context.Context, id string, requester models.Requester) (*models.Order, error) {
+	order, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !requester.CanAccess(order) {
+		return nil, models.ErrNotFound
+	}
+	return order, nil
+}
+
+// List - получение страницы заказов, новые заказы первыми.
+// Покупатель видит только свои заказы, сотрудник - заказы любого пользователя.
+// С курсором используется keyset-пагинация по (created_at, _id), без него - смещение offset.
+// Возвращает курсор следующей страницы, пустой на последней странице.
+func (s *OrderService) List(ctx context.Context, userID string, requester models.Requester, limit, offset int, cursor string) ([]models.Order, string, error) {
+	if !requester.IsStaff() {
+		if requester.UserID == "" {
+			return nil, "", nil
+		}
+		userID = requester.UserID
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	filter := bson.M{}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	if cursor != "" {
+		var position orderCursor
+		if err := s.cursors.Decode(cursor, &position); err != nil || position.UserID != userID {
+			verr := &models.ValidationError{}
+			verr.Add("cursor", pagination.ErrInvalidCursor.Error())
+			return nil, "", verr
+		}
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{"$lt": position.CreatedAt}},
+			bson.M{"created_at": position.CreatedAt, "_id": bson.M{"$lt": position.ID}},
+		}
+		offset = 0
+	}
+
+	// Запрашиваем на один заказ больше, чтобы понять, есть ли следующая страница
+	orders, err := s.repo.List(ctx, filter, limit+1, offset)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(orders) <= limit {
+		return orders, "", nil
+	}
+
+	orders = orders[:limit]
+	last := orders[len(orders)-1]
+	next, err := s.cursors.Encode(orderCursor{CreatedAt: last.CreatedAt, ID: last.ID, UserID: userID})
+	if err != nil {
+		return nil, "", err
+	}
+	return orders, next, nil
 }
 
-// List - получение списка всех продуктов
-func (s *OrderService) List(ctx context.Context, filter bson.M, limit, offset int) ([]models.Order, error) {
-	return s.repo.List(ctx, filter, limit, offset)
+// UpdateOrderStatus - перевод заказа в новый статус по жизненному циклу заказа.
+// Недопустимый переход возвращает *models.TransitionError, покупатель может только отменить свой заказ.
+func (s *OrderService) UpdateOrderStatus(ctx context.Context, id, status, comment string, requester models.Requester) (*models.Order, error) {
+	if !models.IsKnownStatus(status) {
+		verr := &models.ValidationError{}
+		verr.Add("status", fmt.Sprintf("неизвестный статус %q", status))
+		return nil, verr
+	}
+
+	order, err := s.GetByID(ctx, id, requester)
+	if err != nil {
+		return nil, err
+	}
+	if !requester.CanSetStatus(status) {
+		return nil, models.ErrForbidden
+	}
+	if !models.CanTransition(order.Status, status) {
+		return nil, &models.TransitionError{From: order.Status, To: status}
+	}
+	// Пока сага не зарезервировала товар и не авторизовала платеж, заказ можно только отменить
+	if status != models.StatusCancelled {
+		if err := s.checkSagaCompleted(ctx, order.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.repo.UpdateStatus(ctx, order.ID, models.StatusChange{
+		From:      order.Status,
+		To:        status,
+		ActorID:   requester.UserID,
+		ActorRole: requester.Role,
+		Comment:   comment,
+		ChangedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case models.StatusPaid:
+		// Статус меняется до списания, поэтому параллельная отмена не снимет резерв и платеж оплачиваемого заказа
+		if err := s.settlePayment(ctx, order); err != nil {
+			return nil, errors.Join(err, s.revertStatus(ctx, updated, order.Status, requester, err))
+		}
+	case models.StatusCancelled:
+		// Резерв, который не удалось снять, истечет сам; ошибка возвращается, чтобы отмену не считали завершенной молча
+		if err := s.releaseUnpaid(ctx, order); err != nil {
+			return nil, fmt.Errorf("заказ отменен, но резерв или авторизация платежа не сняты: %w", err)
+		}
+	}
+	return updated, nil
 }
 
-// Update - обновление данных продукта
-func (s *OrderService) UpdateOrderStatus(ctx context.Context, Order *models.Order) (*models.Order, error) {
-	// Проверка, существует ли продукт с таким ID
-	existingOrder, err := s.repo.GetByID(ctx, Order.ID)
+// settlePayment - списание зарезервированных остатков и авторизованного платежа оплаченного заказа.
+// Резерв подтверждается первым: истекший резерв - окончательная ошибка, а повторное подтверждение
+// после временной ошибки списания платежа ничего не меняет.
+func (s *OrderService) settlePayment(ctx context.Context, order *models.Order) error {
+	err := s.catalog.ConfirmReservation(ctx, order.ID)
+	if errors.Is(err, models.ErrReservationNotFound) {
+		// Резерва нет только у заказа без позиций с предложениями
+		if hasOfferItems(order) {
+			return models.ErrReservationExpired
+		}
+		err = nil
+	}
 	if err != nil {
-		return nil, errors.New("продукт не найден")
+		return err
 	}
+	if order.PaymentID != "" {
+		return s.payments.Capture(ctx, order.PaymentID)
+	}
+	return nil
+}
+
+// revertStatus - возврат заказа в статус from после неудачной оплаты с причиной в истории статусов
+func (s *OrderService) revertStatus(ctx context.Context, order *models.Order, from string, requester models.Requester, cause error) error {
+	_, err := s.repo.UpdateStatus(ctx, order.ID, models.StatusChange{
+		From:      order.Status,
+		To:        from,
+		ActorID:   requester.UserID,
+		ActorRole: requester.Role,
+		Comment:   "оплата не проведена: " + cause.Error(),
+		ChangedAt: time.Now().UTC(),
+	})
+	return err
+}
 
-	// Обновление данных продукта
-	existingOrder.Status = Order.Status
-	// Сохранение обновленного продукта в базе
-	return s.repo.Update(ctx, existingOrder)
+// History - история статусов заказа в хронологическом порядке
+func (s *OrderService) History(ctx context.Context, id string, requester models.Requester) ([]models.StatusChange, error) {
+	order, err := s.GetByID(ctx, id, requester)
+	if err != nil {
+		return nil, err
+	}
+	return order.StatusHistory, nil
 }
 
-// Delete - удаление продукта по ID
-func (s *OrderService) Delete(ctx context.Context, id string) error {
-	// Проверка, существует ли продукт с таким ID
-	Order, err := s.repo.GetByID(ctx, id)
+// Delete - удаление заказа по ID.
+// Покупатель может удалить свой заказ только в статусе pending или cancelled, сотрудник - любой заказ.
+func (s *OrderService) Delete(ctx context.Context, id string, requester models.Requester) error {
+	order, err := s.GetByID(ctx, id, requester)
 	if err != nil {
-		return errors.New("продукт не найден")
+		return err
+	}
+	if !requester.IsStaff() && order.Status != models.StatusPending && order.Status != models.StatusCancelled {
+		return fmt.Errorf("%w: заказ в статусе %s может удалить только сотрудник", models.ErrForbidden, order.Status)
+	}
+
+	// Резерв и авторизация снимаются до удаления: при ошибке заказ остается и удаление можно повторить
+	if err := s.releaseUnpaid(ctx, order); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, order.ID)
+}
+
+// releaseUnpaid - возврат резерва неоплаченного заказа в остатки и снятие авторизации платежа.
+// Оплаченный заказ не затрагивается: резерв уже списан, платеж проведен.
+func (s *OrderService) releaseUnpaid(ctx context.Context, order *models.Order) error {
+	switch order.Status {
+	case models.StatusPending, models.StatusConfirmed, models.StatusCancelled:
+	default:
+		return nil
 	}
+	if err := s.catalog.ReleaseReservation(ctx, order.ID); err != nil {
+		return err
+	}
+	if order.PaymentID != "" {
+		return s.payments.Void(ctx, order.PaymentID)
+	}
+	return nil
+}
+
+// checkSagaCompleted - проверка, что оформление заказа завершено.
+// Заказы, созданные до появления саги, саги не имеют и считаются оформленными.
+func (s *OrderService) checkSagaCompleted(ctx context.Context, orderID string) error {
+	saga, err := s.sagas.GetByID(ctx, orderID)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if saga.Status != models.SagaCompleted {
+		return models.ErrSagaInProgress
+	}
+	return nil
+}
 
-	// Удаление продукта из базы
-	return s.repo.Delete(ctx, Order.ID)
+// hasOfferItems - в заказе есть позиции с предложениями поставщиков, под которые резервируется товар
+func hasOfferItems(order *models.Order) bool {
+	for _, item := range order.Items {
+		if item.OfferID != "" {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"order-service/internal/catalog"
+	"order-service/internal/models"
+)
+
+// Ограничения состава заказа
+const (
+	maxOrderItems   = 100
+	maxItemQuantity = 999
+)
+
+// defaultCurrency - валюта цен каталога и предложений без указанной валюты
+const defaultCurrency = "RUB"
+
+// validateItems - проверка позиций нового заказа до обращения к каталогу
+func validateItems(items []models.OrderItem, verr *models.ValidationError) {
+	if len(items) == 0 {
+		verr.Add("items", "заказ должен содержать хотя бы одну позицию")
+		return
+	}
+	if len(items) > maxOrderItems {
+		verr.Add("items", fmt.Sprintf("заказ может содержать не более %d позиций", maxOrderItems))
+		return
+	}
+
+	seen := make(map[[2]string]bool, len(items))
+	for i, item := range items {
+		field := fmt.Sprintf("items[%d]", i)
+		if item.ProductID == "" {
+			verr.Add(field+".product_id", "не указан продукт")
+		}
+		if item.Quantity < 1 || item.Quantity > maxItemQuantity {
+			verr.Add(field+".quantity", fmt.Sprintf("количество должно быть от 1 до %d", maxItemQuantity))
+		}
+		key := [2]string{item.ProductID, item.OfferID}
+		if seen[key] {
+			verr.Add(field, "позиция повторяется, укажите общее количество в одной позиции")
+		}
+		seen[key] = true
+	}
+}
+
+// itemQuote - цена позиции по текущему каталогу
+type itemQuote struct {
+	Name      string
+	Supplier  string
+	UnitPrice float64
+	Currency  string
+}
+
+// quoteItem - цена позиции по продукту каталога.
+// Позиция с offerID оценивается по предложению поставщика, без него - по цене продукта в каталоге.
+// Если позицию нельзя заказать, возвращает поле позиции и описание причины.
+func quoteItem(product *models.CatalogProduct, offerID string, quantity int) (itemQuote, string, string) {
+	quote := itemQuote{Name: product.Name, Currency: defaultCurrency}
+	if offerID == "" {
+		if product.Price <= 0 {
+			return quote, "product_id", "у продукта нет цены в каталоге, укажите предложение поставщика"
+		}
+		quote.UnitPrice = product.Price
+		return quote, "", ""
+	}
+
+	offer, ok := product.Offer(offerID)
+	switch {
+	case !ok:
+		return quote, "offer_id", "предложение не найдено"
+	case offer.Price <= 0:
+		return quote, "offer_id", "у предложения нет цены"
+	case offer.Quantity < quantity:
+		return quote, "quantity", fmt.Sprintf("в наличии %d шт.", offer.Quantity)
+	}
+	quote.Supplier = offer.Supplier
+	quote.UnitPrice = offer.Price
+	if offer.Currency != "" {
+		quote.Currency = offer.Currency
+	}
+	return quote, "", ""
+}
+
+// productLookup - продукты каталога, запрошенные в рамках одной операции
+type productLookup struct {
+	catalog  *catalog.ProductsCatalog
+	products map[string]*models.CatalogProduct
+}
+
+func newProductLookup(catalog *catalog.ProductsCatalog) *productLookup {
+	return &productLookup{catalog: catalog, products: make(map[string]*models.CatalogProduct)}
+}
+
+// get - продукт по ID, каждый продукт запрашивается у сервиса продуктов один раз
+func (l *productLookup) get(ctx context.Context, id string) (*models.CatalogProduct, error) {
+	if product, ok := l.products[id]; ok {
+		return product, nil
+	}
+	product, err := l.catalog.Product(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	l.products[id] = product
+	return product, nil
+}
+
+// priceItems - расчет позиций и суммы заказа по текущим ценам каталога.
+// Неизвестные продукты, предложения и позиции в разных валютах возвращаются как ошибка валидации.
+func (s *OrderService) priceItems(ctx context.Context, order *models.Order) error {
+	verr := &models.ValidationError{}
+	products := newProductLookup(s.catalog)
+	order.Currency = ""
+	order.Subtotal = 0
+
+	for i := range order.Items {
+		item := &order.Items[i]
+		field := fmt.Sprintf("items[%d]", i)
+
+		product, err := products.get(ctx, item.ProductID)
+		if errors.Is(err, models.ErrProductNotFound) {
+			verr.Add(field+".product_id", "продукт не найден")
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		quote, badField, problem := quoteItem(product, item.OfferID, item.Quantity)
+		if problem != "" {
+			verr.Add(field+"."+badField, problem)
+			continue
+		}
+		if order.Currency == "" {
+			order.Currency = quote.Currency
+		} else if quote.Currency != order.Currency {
+			verr.Add(field, fmt.Sprintf("валюта позиции %s отличается от валюты заказа %s", quote.Currency, order.Currency))
+			continue
+		}
+
+		item.Name = quote.Name
+		item.Supplier = quote.Supplier
+		item.UnitPrice = quote.UnitPrice
+		item.LineTotal = roundPrice(item.UnitPrice * float64(item.Quantity))
+		order.Subtotal += item.LineTotal
+	}
+	if err := verr.Err(); err != nil {
+		return err
+	}
+
+	order.Subtotal = roundPrice(order.Subtotal)
+	order.TotalPrice = order.Subtotal
+	return nil
+}
+
+// roundPrice - округление суммы до копеек
+func roundPrice(v float64) float64 {
+	return math.Round(v*100) / 100
+}
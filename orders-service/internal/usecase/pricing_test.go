@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"testing"
+
+	"order-service/internal/models"
+)
+
+func TestQuoteItem(t *testing.T) {
+	product := &models.CatalogProduct{
+		ID:    "p1",
+		Name:  "Фильтр масляный",
+		Price: 500,
+		Offers: []models.CatalogOffer{
+			{ID: "o1", Supplier: "Склад", Price: 450, Currency: "USD", Quantity: 3},
+			{ID: "o2", Supplier: "Без валюты", Price: 470, Quantity: 1},
+			{ID: "o3", Supplier: "Без цены", Quantity: 10},
+		},
+	}
+	unpriced := &models.CatalogProduct{ID: "p2", Name: "Без цены"}
+
+	tests := []struct {
+		name      string
+		product   *models.CatalogProduct
+		offerID   string
+		quantity  int
+		want      itemQuote
+		wantField string
+	}{
+		{
+			name: "цена продукта", product: product, quantity: 5,
+			want: itemQuote{Name: "Фильтр масляный", UnitPrice: 500, Currency: defaultCurrency},
+		},
+		{
+			name: "предложение с валютой", product: product, offerID: "o1", quantity: 3,
+			want: itemQuote{Name: "Фильтр масляный", Supplier: "Склад", UnitPrice: 450, Currency: "USD"},
+		},
+		{
+			name: "предложение без валюты", product: product, offerID: "o2", quantity: 1,
+			want: itemQuote{Name: "Фильтр масляный", Supplier: "Без валюты", UnitPrice: 470, Currency: defaultCurrency},
+		},
+		{name: "продукт без цены", product: unpriced, quantity: 1, wantField: "product_id"},
+		{name: "неизвестное предложение", product: product, offerID: "missing", quantity: 1, wantField: "offer_id"},
+		{name: "предложение без цены", product: product, offerID: "o3", quantity: 1, wantField: "offer_id"},
+		{name: "не хватает остатка", product: product, offerID: "o1", quantity: 4, wantField: "quantity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, field, problem := quoteItem(tt.product, tt.offerID, tt.quantity)
+			if field != tt.wantField {
+				t.Fatalf("поле %q (%s), ожидалось %q", field, problem, tt.wantField)
+			}
+			if tt.wantField != "" {
+				if problem == "" {
+					t.Error("не указана причина")
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("получено %+v, ожидалось %+v", got, tt.want)
+			}
+		})
+	}
+}
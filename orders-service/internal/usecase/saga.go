@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"order-service/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Параметры выполнения саги оформления заказа
+const (
+	// sagaLease - время аренды саги обработчиком; после сбоя сагу продолжит другой обработчик
+	sagaLease = time.Minute
+	// sagaBaseBackoff и sagaMaxBackoff - пауза перед повтором шага, удваивается с каждой попыткой
+	sagaBaseBackoff = time.Second
+	sagaMaxBackoff  = 5 * time.Minute
+	// maxStepAttempts - попытки шага при временных ошибках, после которых выполненные шаги отменяются
+	maxStepAttempts = 8
+	// maxCompensationAttempts - попытки компенсации шага, после которых сага требует разбора вручную
+	maxCompensationAttempts = 20
+	// sagaBatchSize - число саг, продолжаемых за один проход
+	sagaBatchSize = 100
+	// sagaActorRole - роль в истории статусов для переходов, выполненных сагой
+	sagaActorRole = "system"
+)
+
+// sagaRun - выполнение саги обработчиком, владеющим арендой
+type sagaRun struct {
+	saga  *models.Saga
+	token string
+}
+
+// startSaga - сохранение заказа и оформление его через сагу: резерв остатков, авторизация платежа, подтверждение заказа.
+// Временные ошибки повторяются в фоне, и заказ возвращается в статусе pending.
+// Если шаг не удался окончательно, выполненные шаги отменяются, заказ отменяется и возвращается ошибка шага.
+func (s *OrderService) startSaga(ctx context.Context, order *models.Order, requester models.Requester) (*models.Order, error) {
+	// Оформление не прерывается отменой запроса клиентом: сага сохраняет состояние после каждого шага
+	ctx = context.WithoutCancel(ctx)
+
+	now := time.Now().UTC()
+	if order.ID == "" {
+		order.ID = uuid.NewString()
+	}
+	run := &sagaRun{saga: models.NewSaga(order.ID, now), token: uuid.NewString()}
+	run.saga.LockToken = run.token
+	run.saga.LockedUntil = now.Add(sagaLease)
+	if err := s.sagas.Create(ctx, run.saga); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.insert(ctx, order, requester); err != nil {
+		// Без заказа выполнять нечего, сага завершается без шагов
+		run.saga.Status = models.SagaCompensated
+		run.saga.Error = err.Error()
+		return nil, errors.Join(err, s.unlockSaga(ctx, run))
+	}
+
+	cause, err := s.runSaga(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	if cause != nil {
+		return nil, cause
+	}
+	return s.repo.GetByID(ctx, order.ID)
+}
+
+// ResumeSagas - продолжение саг, время повтора которых наступило, в том числе прерванных перезапуском сервиса.
+// Возвращает количество обработанных саг.
+func (s *OrderService) ResumeSagas(ctx context.Context) (int, error) {
+	var errs []error
+	processed := 0
+	for processed < sagaBatchSize {
+		now := time.Now().UTC()
+		token := uuid.NewString()
+		saga, err := s.sagas.ClaimDue(ctx, now, token, now.Add(sagaLease))
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if saga == nil {
+			break
+		}
+
+		processed++
+		if _, err := s.runSaga(ctx, &sagaRun{saga: saga, token: token}); err != nil {
+			errs = append(errs, fmt.Errorf("сага заказа %s: %w", saga.ID, err))
+		}
+	}
+	return processed, errors.Join(errs...)
+}
+
+// runSaga - выполнение шагов саги до завершения, окончательной ошибки или временной ошибки с отложенным повтором.
+// cause - ошибка шага, из-за которой заказ отменен; err - ошибка сохранения состояния саги.
+func (s *OrderService) runSaga(ctx context.Context, run *sagaRun) (cause error, err error) {
+	saga := run.saga
+	for saga.Status == models.SagaRunning {
+		step := saga.NextStep()
+		if step == nil {
+			saga.Status = models.SagaCompleted
+			return nil, s.unlockSaga(ctx, run)
+		}
+
+		stepErr := s.executeStep(ctx, saga, step.Name)
+		step.Attempts++
+		step.UpdatedAt = time.Now().UTC()
+		if stepErr == nil {
+			step.Status = models.StepDone
+			step.LastError = ""
+			if err := s.sagas.Save(ctx, saga, run.token); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		step.LastError = stepErr.Error()
+		if isRetryable(stepErr) && step.Attempts < maxStepAttempts {
+			return nil, s.retrySaga(ctx, run, step.Attempts)
+		}
+		saga.Status = models.SagaCompensating
+		saga.Error = fmt.Sprintf("%s: %v", step.Name, stepErr)
+		// Дальше попытки считаются для компенсации выполненных шагов
+		for i := range saga.Steps {
+			if saga.Steps[i].Status == models.StepDone {
+				saga.Steps[i].Attempts = 0
+			}
+		}
+		if err := s.sagas.Save(ctx, saga, run.token); err != nil {
+			return nil, err
+		}
+		cause = stepErr
+	}
+
+	for saga.Status == models.SagaCompensating {
+		step := saga.NextCompensation()
+		if step == nil {
+			if err := s.cancelBySaga(ctx, saga); err != nil {
+				return cause, s.retrySaga(ctx, run, 1)
+			}
+			saga.Status = models.SagaCompensated
+			return cause, s.unlockSaga(ctx, run)
+		}
+
+		compErr := s.compensateStep(ctx, saga, step.Name)
+		step.Attempts++
+		step.UpdatedAt = time.Now().UTC()
+		if compErr == nil {
+			step.Status = models.StepCompensated
+			step.LastError = ""
+			if err := s.sagas.Save(ctx, saga, run.token); err != nil {
+				return cause, err
+			}
+			continue
+		}
+
+		step.LastError = compErr.Error()
+		if step.Attempts >= maxCompensationAttempts {
+			// Сага остается для разбора вручную, но заказ не должен висеть в pending
+			saga.Status = models.SagaFailed
+			return cause, errors.Join(s.failSaga(ctx, saga), s.unlockSaga(ctx, run))
+		}
+		return cause, s.retrySaga(ctx, run, step.Attempts)
+	}
+	return cause, nil
+}
+
+// executeStep - выполнение шага саги
+func (s *OrderService) executeStep(ctx context.Context, saga *models.Saga, step string) error {
+	order, err := s.repo.GetByID(ctx, saga.ID)
+	if err != nil {
+		return err
+	}
+	if order.Status == models.StatusCancelled {
+		return errOrderCancelled
+	}
+
+	switch step {
+	case models.StepReserveStock:
+		until, err := s.catalog.ReserveStock(ctx, order.ID, order.Items)
+		if err != nil {
+			return err
+		}
+		return s.repo.SetReservation(ctx, order.ID, until)
+
+	case models.StepAuthorizePayment:
+		paymentID, err := s.payments.Authorize(ctx, order.ID, order.TotalPrice, order.Currency)
+		if err != nil {
+			return err
+		}
+		saga.PaymentID = paymentID
+		return s.repo.SetPayment(ctx, order.ID, paymentID)
+
+	case models.StepConfirmOrder:
+		if order.Status != models.StatusPending {
+			// Заказ уже подтвержден при прошлой попытке, сохранить шаг которой не удалось
+			return nil
+		}
+		_, err := s.repo.UpdateStatus(ctx, order.ID, models.StatusChange{
+			From:      models.StatusPending,
+			To:        models.StatusConfirmed,
+			ActorRole: sagaActorRole,
+			Comment:   "товар зарезервирован, платеж авторизован",
+			ChangedAt: time.Now().UTC(),
+		})
+		if errors.Is(err, models.ErrStatusConflict) {
+			// Статус изменился параллельно: подтверждение завершает шаг, отмена - нет
+			if current, getErr := s.repo.GetByID(ctx, order.ID); getErr == nil && current.Status != models.StatusCancelled {
+				return nil
+			}
+		}
+		return err
+	}
+	return fmt.Errorf("неизвестный шаг саги %q", step)
+}
+
+// compensateStep - отмена выполненного шага саги
+func (s *OrderService) compensateStep(ctx context.Context, saga *models.Saga, step string) error {
+	switch step {
+	case models.StepReserveStock:
+		return s.catalog.ReleaseReservation(ctx, saga.ID)
+	case models.StepAuthorizePayment:
+		if saga.PaymentID == "" {
+			return nil
+		}
+		return s.payments.Void(ctx, saga.PaymentID)
+	}
+	// Подтверждение заказа - последний шаг, после него компенсация не запускается
+	return nil
+}
+
+// cancelBySaga - отмена заказа после компенсации шагов. Удаленный или уже отмененный заказ не считается ошибкой.
+func (s *OrderService) cancelBySaga(ctx context.Context, saga *models.Saga) error {
+	_, err := s.repo.UpdateStatus(ctx, saga.ID, models.StatusChange{
+		From:      models.StatusPending,
+		To:        models.StatusCancelled,
+		ActorRole: sagaActorRole,
+		Comment:   saga.Error,
+		ChangedAt: time.Now().UTC(),
+	})
+	if errors.Is(err, models.ErrStatusConflict) || errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// failSaga - отмена заказа, компенсация которого не удалась.
+// Резерв снимается еще раз: если компенсация не удалась на платеже, остатки возвращаются сразу, а не по истечении резерва.
+func (s *OrderService) failSaga(ctx context.Context, saga *models.Saga) error {
+	return errors.Join(s.cancelBySaga(ctx, saga), s.catalog.ReleaseReservation(ctx, saga.ID))
+}
+
+// retrySaga - отложенный повтор с экспоненциальной паузой и освобождение аренды
+func (s *OrderService) retrySaga(ctx context.Context, run *sagaRun, attempts int) error {
+	run.saga.NextAttemptAt = time.Now().UTC().Add(sagaBackoff(attempts))
+	return s.unlockSaga(ctx, run)
+}
+
+// unlockSaga - сохранение состояния саги с освобождением аренды
+func (s *OrderService) unlockSaga(ctx context.Context, run *sagaRun) error {
+	run.saga.LockToken = ""
+	run.saga.LockedUntil = time.Time{}
+	run.saga.UpdatedAt = time.Now().UTC()
+	return s.sagas.Save(ctx, run.saga, run.token)
+}
+
+// errOrderCancelled - заказ отменен до завершения саги
+var errOrderCancelled = errors.New("заказ отменен")
+
+// isRetryable - временная ошибка шага, которую имеет смысл повторить.
+// Отказ платежа, нехватка товара, отклоненные позиции и отмена или удаление заказа не повторяются.
+func isRetryable(err error) bool {
+	var validationErr *models.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		return false
+	case errors.Is(err, models.ErrOutOfStock),
+		errors.Is(err, models.ErrPaymentDeclined),
+		errors.Is(err, models.ErrNotFound),
+		errors.Is(err, models.ErrStatusConflict),
+		errors.Is(err, errOrderCancelled):
+		return false
+	}
+	return true
+}
+
+// sagaBackoff - пауза перед попыткой attempts+1
+func sagaBackoff(attempts int) time.Duration {
+	backoff := sagaBaseBackoff
+	for i := 1; i < attempts && backoff < sagaMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, sagaMaxBackoff)
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"order-service/internal/models"
+	"order-service/internal/payment"
+
+	"go.uber.org/zap"
+)
+
+func TestSagaBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: time.Second},
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 8, want: 128 * time.Second},
+		{attempts: 9, want: 256 * time.Second},
+		{attempts: 10, want: sagaMaxBackoff},
+		{attempts: 100, want: sagaMaxBackoff},
+	}
+	for _, tt := range tests {
+		if got := sagaBackoff(tt.attempts); got != tt.want {
+			t.Errorf("sagaBackoff(%d) = %v, ожидалось %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "платежный сервис недоступен", err: models.ErrPaymentUnavailable, want: true},
+		{name: "сервис продуктов недоступен", err: fmt.Errorf("%w: timeout", models.ErrCatalogUnavailable), want: true},
+		{name: "платеж отклонен", err: fmt.Errorf("%w: лимит", models.ErrPaymentDeclined), want: false},
+		{name: "нет в наличии", err: models.ErrOutOfStock, want: false},
+		{name: "заказ удален", err: models.ErrNotFound, want: false},
+		{name: "заказ отменен", err: errOrderCancelled, want: false},
+		{name: "отклоненные позиции", err: &models.ValidationError{Violations: []models.FieldViolation{{Field: "items[0]"}}}, want: false},
+	}
+	for _, tt := range tests {
+		if got := isRetryable(tt.err); got != tt.want {
+			t.Errorf("%s: isRetryable = %v, ожидалось %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestSagaPaymentCompensation - авторизация платежа через заглушку и ее отмена компенсацией саги
+func TestSagaPaymentCompensation(t *testing.T) {
+	ctx := context.Background()
+	stub := payment.NewStub(1000, 0, zap.NewNop())
+	s := &OrderService{payments: stub}
+
+	// Сумма выше лимита заглушки отклоняется окончательно, и сага переходит к компенсации без повторов
+	if _, err := stub.Authorize(ctx, "declined", 1500, "RUB"); !errors.Is(err, models.ErrPaymentDeclined) || isRetryable(err) {
+		t.Fatalf("ошибка %v, ожидался окончательный отказ платежа", err)
+	}
+
+	saga := models.NewSaga("o1", time.Now().UTC())
+	paymentID, err := stub.Authorize(ctx, saga.ID, 900, "RUB")
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	saga.PaymentID = paymentID
+	saga.Steps[0].Status = models.StepDone
+	saga.Steps[1].Status = models.StepDone
+	saga.Status = models.SagaCompensating
+
+	step := saga.NextCompensation()
+	if step == nil || step.Name != models.StepAuthorizePayment {
+		t.Fatalf("первым отменяется шаг %v, ожидался %s", step, models.StepAuthorizePayment)
+	}
+	if err := s.compensateStep(ctx, saga, step.Name); err != nil {
+		t.Fatalf("compensateStep: %v", err)
+	}
+	step.Status = models.StepCompensated
+
+	// Отмененную авторизацию нельзя списать, повторная компенсация не считается ошибкой
+	if err := stub.Capture(ctx, paymentID); !errors.Is(err, models.ErrPaymentDeclined) {
+		t.Errorf("списание отмененного платежа: ошибка %v, ожидалась %v", err, models.ErrPaymentDeclined)
+	}
+	if err := s.compensateStep(ctx, saga, models.StepAuthorizePayment); err != nil {
+		t.Errorf("повторная компенсация: %v", err)
+	}
+
+	if step := saga.NextCompensation(); step == nil || step.Name != models.StepReserveStock {
+		t.Errorf("следующим отменяется шаг %v, ожидался %s", step, models.StepReserveStock)
+	}
+
+	// Шаг авторизации без платежа отменять нечего
+	if err := s.compensateStep(ctx, &models.Saga{ID: "o2"}, models.StepAuthorizePayment); err != nil {
+		t.Errorf("компенсация без платежа: %v", err)
+	}
+}
@@ -2,17 +2,25 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
+	"os/signal"
+	"product-service/internal/config"
 	"product-service/internal/delivery"
+	"product-service/internal/healthcheck"
 	"product-service/internal/proto" // Путь к вашему сгенерированному файлу
 	"product-service/internal/repository"
 	"product-service/internal/usecase"
+	"syscall"
+	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap" // Импортируем zap для логирования
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -24,8 +32,14 @@ func main() {
 	}
 	defer logger.Sync() // Закрытие логгера при завершении работы программы
 
+	// Загружаем конфигурацию
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Ошибка загрузки конфигурации", zap.Error(err))
+	}
+
 	// Подключаемся к MongoDB
-	clientOptions := options.Client().ApplyURI("mongodb://localhost:27017")
+	clientOptions := options.Client().ApplyURI(cfg.Mongo.URI)
 	client, err := mongo.Connect(context.Background(), clientOptions)
 	if err != nil {
 		logger.Fatal("Ошибка при подключении к MongoDB", zap.Error(err))
@@ -38,30 +52,130 @@ func main() {
 	}
 
 	// Получаем доступ к нужной базе данных
-	db := client.Database("productDB") // Используйте имя вашей базы данных
+	db := client.Database(cfg.Mongo.Database)
 
 	// Создаем gRPC сервер
 	server := grpc.NewServer()
 
 	// Создаем репозиторий, сервис и обработчик
+	fitments := repository.NewFitmentRepository(db)
+	if err := fitments.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Не удалось создать индексы коллекции применимости", zap.Error(err))
+	}
+	crossrefs := repository.NewCrossReferenceRepository(db)
+	if err := crossrefs.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Не удалось создать индексы коллекции кроссов", zap.Error(err))
+	}
+	offers := repository.NewOfferRepository(db)
+	if err := offers.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Не удалось создать индексы коллекции предложений", zap.Error(err))
+	}
+	imports := repository.NewImportJobRepository(db)
+	if err := imports.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Не удалось создать индексы коллекции задач импорта", zap.Error(err))
+	}
+	// Задачи импорта выполняются в памяти процесса и не переживают перезапуск
+	if n, err := imports.FailInterrupted(context.Background(), "импорт прерван перезапуском сервиса"); err != nil {
+		logger.Warn("Не удалось завершить прерванные задачи импорта", zap.Error(err))
+	} else if n > 0 {
+		logger.Warn("Прерванные задачи импорта завершены с ошибкой", zap.Int64("count", n))
+	}
+	reservations := repository.NewReservationRepository(db)
+	if err := reservations.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Не удалось создать индексы коллекции резервов", zap.Error(err))
+	}
 	repository := repository.NewProductRepository(db)
-	service := usecase.NewProductService(repository)
+	if err := repository.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Не удалось создать индексы коллекции продуктов", zap.Error(err))
+	}
+	service := usecase.NewProductService(repository, fitments, crossrefs, offers, imports, reservations, cfg.Reservations.TTL)
 	handler := delivery.NewProductHandler(service, logger) // Передаем логгер в обработчик
 
 	// Регистрируем сервис (например, ProductService)
 	proto.RegisterProductServiceServer(server, handler)
 
+	// Регистрируем стандартный сервис проверки состояния
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
 	// Включаем рефлексию
 	reflection.Register(server)
 
 	// Настроим и запустим сервер
-	listener, err := net.Listen("tcp", ":9091")
+	addr := fmt.Sprintf(":%d", cfg.GRPC.Port)
+	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		logger.Fatal("Ошибка при создании слушателя", zap.Error(err))
 	}
 
-	logger.Info("Сервер запущен на :9091")
-	if err := server.Serve(listener); err != nil {
-		logger.Fatal("Ошибка при запуске сервера", zap.Error(err))
+	// Останавливаемся по SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Статус сервера отражает доступность MongoDB
+	go healthcheck.WatchMongo(ctx, client, healthServer, logger, proto.ProductService_ServiceDesc.ServiceName)
+
+	// Истекшие резервы снимаются в фоне, остатки возвращаются в предложения
+	go sweepReservations(ctx, service, cfg.Reservations.SweepInterval, logger)
+
+	go func() {
+		logger.Info("Сервер запущен", zap.String("addr", addr))
+		if err := server.Serve(listener); err != nil {
+			logger.Fatal("Ошибка при запуске сервера", zap.Error(err))
+		}
+	}()
+
+	<-ctx.Done()
+	logger.Info("Получен сигнал завершения, останавливаем сервер", zap.Duration("timeout", cfg.ShutdownTimeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	// Сообщаем клиентам, что сервер больше не принимает запросы
+	healthServer.Shutdown()
+	gracefulStop(shutdownCtx, server, logger)
+
+	// Закрываем соединение с MongoDB, по истечении таймаута активные операции прерываются
+	if err := client.Disconnect(shutdownCtx); err != nil {
+		logger.Error("Ошибка при отключении от MongoDB", zap.Error(err))
+	}
+	logger.Info("Сервер остановлен")
+}
+
+// sweepReservations - периодическое снятие истекших резервов до отмены ctx
+func sweepReservations(ctx context.Context, service *usecase.ProductService, interval time.Duration, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		swept, err := service.SweepExpiredReservations(ctx)
+		if err != nil {
+			logger.Error("Ошибка снятия истекших резервов", zap.Error(err))
+		}
+		if swept > 0 {
+			logger.Info("Сняты истекшие резервы", zap.Int("count", swept))
+		}
+	}
+}
+
+// gracefulStop - ожидание завершения активных запросов, по истечении ctx соединения закрываются принудительно
+func gracefulStop(ctx context.Context, server *grpc.Server, logger *zap.Logger) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		logger.Warn("Истекло время ожидания завершения запросов, принудительная остановка")
+		server.Stop()
 	}
 }
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config - конфигурация сервиса товаров
+type Config struct {
+	GRPC  GRPCConfig  `yaml:"grpc"`
+	Mongo MongoConfig `yaml:"mongo"`
+	// Reservations - резервы остатков под заказы
+	Reservations ReservationsConfig `yaml:"reservations"`
+	// ShutdownTimeout - время на завершение активных запросов при остановке
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// GRPCConfig - параметры gRPC-сервера
+type GRPCConfig struct {
+	Port int `yaml:"port"`
+}
+
+// MongoConfig - параметры подключения к MongoDB
+type MongoConfig struct {
+	URI      string `yaml:"uri"`
+	Database string `yaml:"database"`
+}
+
+// ReservationsConfig - параметры резервов остатков
+type ReservationsConfig struct {
+	// TTL - время жизни резерва, если заказ не указал свое
+	TTL time.Duration `yaml:"ttl"`
+	// SweepInterval - период снятия истекших резервов
+	SweepInterval time.Duration `yaml:"sweep_interval"`
+}
+
+// Load - загрузка конфигурации.
+// Порядок применения: значения по умолчанию, YAML-файл из CONFIG_PATH (если задан), переменные окружения.
+func Load() (*Config, error) {
+	cfg := &Config{
+		GRPC: GRPCConfig{
+			Port: 9091,
+		},
+		Mongo: MongoConfig{
+			URI:      "mongodb://localhost:27017",
+			Database: "productDB",
+		},
+		Reservations: ReservationsConfig{
+			TTL:           30 * time.Minute,
+			SweepInterval: time.Minute,
+		},
+		ShutdownTimeout: 15 * time.Second,
+	}
+
+	if path := os.Getenv("CONFIG_PATH"); path != "" {
+		if err := loadYAML(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("невалидная конфигурация: %w", err)
+	}
+	return cfg, nil
+}
+
+// Validate - проверка корректности конфигурации
+func (c *Config) Validate() error {
+	var errs []error
+	if c.GRPC.Port <= 0 || c.GRPC.Port > 65535 {
+		errs = append(errs, fmt.Errorf("grpc.port (GRPC_PORT): порт %d вне диапазона 1-65535", c.GRPC.Port))
+	}
+	if c.Mongo.URI == "" {
+		errs = append(errs, errors.New("mongo.uri (MONGO_URI): значение не задано"))
+	}
+	if c.Mongo.Database == "" {
+		errs = append(errs, errors.New("mongo.database (MONGO_DATABASE): значение не задано"))
+	}
+	if c.Reservations.TTL <= 0 || c.Reservations.TTL > 24*time.Hour {
+		errs = append(errs, errors.New("reservations.ttl (RESERVATION_TTL): время должно быть от 1s до 24h"))
+	}
+	if c.Reservations.SweepInterval <= 0 {
+		errs = append(errs, errors.New("reservations.sweep_interval (RESERVATION_SWEEP_INTERVAL): время должно быть положительным"))
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown_timeout (SHUTDOWN_TIMEOUT): время должно быть положительным"))
+	}
+	return errors.Join(errs...)
+}
+
+// loadEnv - переопределение параметров переменными окружения
+func (c *Config) loadEnv() error {
+	envString(&c.Mongo.URI, "MONGO_URI")
+	envString(&c.Mongo.Database, "MONGO_DATABASE")
+	return errors.Join(
+		envInt(&c.GRPC.Port, "GRPC_PORT"),
+		envDuration(&c.Reservations.TTL, "RESERVATION_TTL"),
+		envDuration(&c.Reservations.SweepInterval, "RESERVATION_SWEEP_INTERVAL"),
+		envDuration(&c.ShutdownTimeout, "SHUTDOWN_TIMEOUT"),
+	)
+}
+
+func loadYAML(path string, cfg *Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("не удалось открыть файл конфигурации: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("не удалось разобрать файл конфигурации %s: %w", path, err)
+	}
+	return nil
+}
+
+func envString(dst *string, key string) {
+	if value, ok := os.LookupEnv(key); ok {
+		*dst = value
+	}
+}
+
+func envInt(dst *int, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалось целое число, получено %q", key, value)
+	}
+	*dst = n
+	return nil
+}
+
+func envDuration(dst *time.Duration, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалась длительность (например, 15s), получено %q", key, value)
+	}
+	*dst = d
+	return nil
+}
@@ -0,0 +1,222 @@
+package delivery
+
+import (
+	"fmt"
+	"product-service/internal/models"
+	"product-service/internal/proto"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoProduct - преобразование модели продукта в gRPC-сообщение
+func toProtoProduct(product *models.Product) (*proto.Product, error) {
+	attributes, err := attributesToProto(product.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("продукт %s: %w", product.ID, err)
+	}
+
+	return &proto.Product{
+		Id:          product.ID,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+		Category:    product.Category,
+		Attributes:  attributes,
+		CreatedAt:   timestamppb.New(product.CreatedAt),
+		UpdatedAt:   timestamppb.New(product.UpdatedAt),
+		Brand:       product.Brand,
+		Article:     product.Article,
+
+		ArticleNormalized: product.ArticleNormalized,
+		BestOffer:         toProtoOffer(product.BestOffer),
+		OfferCount:        int32(product.OfferCount),
+	}, nil
+}
+
+// fromProtoProduct - преобразование gRPC-сообщения в модель продукта.
+// Время создания и изменения и нормализованный артикул выставляет сервис, поэтому из запроса они не берутся.
+func fromProtoProduct(req *proto.Product) *models.Product {
+	var attributes map[string]any
+	if req.GetAttributes() != nil {
+		attributes = req.GetAttributes().AsMap()
+	}
+
+	return &models.Product{
+		ID:          req.GetId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Price:       req.GetPrice(),
+		Category:    req.GetCategory(),
+		Attributes:  attributes,
+		Brand:       req.GetBrand(),
+		Article:     req.GetArticle(),
+	}
+}
+
+// vehicleFromProto - преобразование автомобиля из gRPC-запроса, nil для пустого значения
+func vehicleFromProto(v *proto.Vehicle) *models.Vehicle {
+	if v == nil {
+		return nil
+	}
+	return &models.Vehicle{
+		Make:       v.GetMake(),
+		Model:      v.GetModel(),
+		Year:       int(v.GetYear()),
+		EngineCode: v.GetEngineCode(),
+		Body:       v.GetBody(),
+	}
+}
+
+// toProtoFitments - преобразование применимости продукта в gRPC-сообщение
+func toProtoFitments(productID string, fitments []models.Fitment) *proto.ProductFitments {
+	resp := &proto.ProductFitments{ProductId: productID}
+	for _, f := range fitments {
+		resp.Fitments = append(resp.Fitments, &proto.Fitment{
+			Id:         f.ID,
+			Make:       f.Make,
+			Model:      f.Model,
+			Generation: f.Generation,
+			YearFrom:   int32(f.YearFrom),
+			YearTo:     int32(f.YearTo),
+			EngineCode: f.EngineCode,
+			Body:       f.Body,
+		})
+	}
+	return resp
+}
+
+// fromProtoFitments - преобразование применимости из gRPC-запроса
+func fromProtoFitments(req *proto.ProductFitments) []models.Fitment {
+	fitments := make([]models.Fitment, 0, len(req.GetFitments()))
+	for _, f := range req.GetFitments() {
+		fitments = append(fitments, models.Fitment{
+			Make:       f.GetMake(),
+			Model:      f.GetModel(),
+			Generation: f.GetGeneration(),
+			YearFrom:   int(f.GetYearFrom()),
+			YearTo:     int(f.GetYearTo()),
+			EngineCode: f.GetEngineCode(),
+			Body:       f.GetBody(),
+		})
+	}
+	return fitments
+}
+
+// toProtoOffer - преобразование предложения поставщика в gRPC-сообщение, nil для пустого значения
+func toProtoOffer(offer *models.Offer) *proto.Offer {
+	if offer == nil {
+		return nil
+	}
+	return &proto.Offer{
+		Id:           offer.ID,
+		ProductId:    offer.ProductID,
+		Supplier:     offer.Supplier,
+		Price:        offer.Price,
+		Currency:     offer.Currency,
+		Quantity:     int32(offer.Quantity),
+		Reserved:     int32(offer.Reserved),
+		DeliveryDays: int32(offer.DeliveryDays),
+		UpdatedAt:    timestamppb.New(offer.UpdatedAt),
+		Url:          offer.URL,
+		Title:        offer.Title,
+		Condition:    offer.Condition,
+		ImageUrl:     offer.ImageURL,
+		Seller:       offer.Seller,
+		Location:     offer.Location,
+	}
+}
+
+// fromProtoOffer - преобразование предложения из gRPC-запроса.
+// ID и время изменения выставляет сервис.
+func fromProtoOffer(offer *proto.Offer) models.Offer {
+	return models.Offer{
+		ProductID:    offer.GetProductId(),
+		Supplier:     offer.GetSupplier(),
+		Price:        offer.GetPrice(),
+		Currency:     offer.GetCurrency(),
+		Quantity:     int(offer.GetQuantity()),
+		DeliveryDays: int(offer.GetDeliveryDays()),
+		URL:          offer.GetUrl(),
+		Title:        offer.GetTitle(),
+		Condition:    offer.GetCondition(),
+		ImageURL:     offer.GetImageUrl(),
+		Seller:       offer.GetSeller(),
+		Location:     offer.GetLocation(),
+	}
+}
+
+// offerSortFromProto - преобразование порядка сортировки предложений из gRPC-запроса
+func offerSortFromProto(sort proto.OfferSort) string {
+	if sort == proto.OfferSort_OFFER_SORT_DELIVERY {
+		return models.OfferSortDelivery
+	}
+	return models.OfferSortPrice
+}
+
+// sortFromProto - преобразование порядка сортировки из gRPC-запроса
+func sortFromProto(sort proto.ProductSort) string {
+	switch sort {
+	case proto.ProductSort_PRODUCT_SORT_RELEVANCE:
+		return models.SortRelevance
+	case proto.ProductSort_PRODUCT_SORT_PRICE_ASC:
+		return models.SortPriceAsc
+	case proto.ProductSort_PRODUCT_SORT_PRICE_DESC:
+		return models.SortPriceDesc
+	case proto.ProductSort_PRODUCT_SORT_NEWEST:
+		return models.SortNewest
+	}
+	return ""
+}
+
+// attributesToProto - преобразование характеристик продукта в google.protobuf.Struct
+func attributesToProto(attributes map[string]any) (*structpb.Struct, error) {
+	if attributes == nil {
+		return nil, nil
+	}
+	normalized, ok := normalizeBSON(attributes).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("неожиданный тип характеристик %T", attributes)
+	}
+	s, err := structpb.NewStruct(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("некорректные характеристики: %w", err)
+	}
+	return s, nil
+}
+
+// normalizeBSON - приведение вложенных значений, прочитанных из MongoDB (primitive.D, primitive.A и т.д.),
+// к типам, которые поддерживает structpb
+func normalizeBSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = normalizeBSON(item)
+		}
+		return out
+	case primitive.M:
+		return normalizeBSON(map[string]any(v))
+	case primitive.D:
+		out := make(map[string]any, len(v))
+		for _, elem := range v {
+			out[elem.Key] = normalizeBSON(elem.Value)
+		}
+		return out
+	case primitive.A:
+		return normalizeBSON([]any(v))
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = normalizeBSON(item)
+		}
+		return out
+	case primitive.DateTime:
+		return v.Time().UTC().Format(time.RFC3339)
+	case primitive.ObjectID:
+		return v.Hex()
+	}
+	return value
+}
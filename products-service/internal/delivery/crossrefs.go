@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"context"
+	"product-service/internal/models"
+	"product-service/internal/proto"
+
+	"go.uber.org/zap"
+)
+
+// FindByArticle - обработка запроса на поиск продукта по артикулу и его аналогов
+func (h *ProductHandler) FindByArticle(ctx context.Context, req *proto.FindByArticleRequest) (*proto.FindByArticleResponse, error) {
+	h.logger.Info("Получен запрос на поиск по артикулу", zap.String("article", req.Article), zap.String("brand", req.Brand))
+
+	match, err := h.service.FindByArticle(ctx, req.Article, req.Brand)
+	if err != nil {
+		h.logger.Error("Ошибка при поиске по артикулу", zap.String("article", req.Article), zap.Error(err))
+		return nil, toStatusError(err, "не удалось найти продукт по артикулу")
+	}
+
+	resp := &proto.FindByArticleResponse{ArticleNormalized: match.ArticleNormalized}
+	if resp.Exact, err = h.protoProducts(match.Exact); err != nil {
+		return nil, err
+	}
+	if resp.Analogs, err = h.protoProducts(match.Analogs); err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("Поиск по артикулу выполнен", zap.String("article", match.ArticleNormalized),
+		zap.Int("exact", len(resp.Exact)), zap.Int("analogs", len(resp.Analogs)))
+	return resp, nil
+}
+
+// AddCrossReferences - обработка запроса на добавление кроссов
+func (h *ProductHandler) AddCrossReferences(ctx context.Context, req *proto.AddCrossReferencesRequest) (*proto.AddCrossReferencesResponse, error) {
+	h.logger.Info("Получен запрос на добавление кроссов", zap.Int("count", len(req.CrossReferences)))
+
+	refs := make([]models.CrossReference, 0, len(req.CrossReferences))
+	for _, ref := range req.CrossReferences {
+		refs = append(refs, models.CrossReference{
+			Brand:         ref.GetBrand(),
+			Article:       ref.GetArticle(),
+			AnalogBrand:   ref.GetAnalogBrand(),
+			AnalogArticle: ref.GetAnalogArticle(),
+		})
+	}
+
+	added, err := h.service.AddCrossReferences(ctx, refs)
+	if err != nil {
+		h.logger.Error("Ошибка при добавлении кроссов", zap.Error(err))
+		return nil, toStatusError(err, "не удалось добавить кроссы")
+	}
+
+	h.logger.Info("Кроссы добавлены", zap.Int64("added", added))
+	return &proto.AddCrossReferencesResponse{Added: added}, nil
+}
+
+// protoProducts - преобразование списка продуктов в gRPC-сообщения
+func (h *ProductHandler) protoProducts(products []models.Product) ([]*proto.Product, error) {
+	result := make([]*proto.Product, 0, len(products))
+	for _, product := range products {
+		protoProduct, err := toProtoProduct(&product)
+		if err != nil {
+			h.logger.Error("Ошибка преобразования продукта", zap.String("id", product.ID), zap.Error(err))
+			return nil, toStatusError(err, "не удалось сформировать ответ")
+		}
+		result = append(result, protoProduct)
+	}
+	return result, nil
+}
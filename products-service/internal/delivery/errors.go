@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"product-service/internal/models"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError - преобразование ошибки бизнес-логики в gRPC-статус
+func toStatusError(err error, msg string) error {
+	var validationErr *models.ValidationError
+	var stockErr *models.InsufficientStockError
+	var stateErr *models.ReservationStateError
+	switch {
+	case errors.As(err, &validationErr):
+		return validationStatus(validationErr)
+	case errors.As(err, &stockErr):
+		return stockStatus(stockErr)
+	case errors.As(err, &stateErr):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, models.ErrNotFound), errors.Is(err, models.ErrReservationNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, models.ErrReservationInProgress):
+		return status.Errorf(codes.Aborted, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// validationStatus - статус InvalidArgument с перечнем нарушений в errdetails.BadRequest
+func validationStatus(err *models.ValidationError) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range err.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	detailed, detailsErr := st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// stockStatus - статус FailedPrecondition с нехваткой по каждому предложению в errdetails.PreconditionFailure
+func stockStatus(err *models.InsufficientStockError) error {
+	st := status.New(codes.FailedPrecondition, err.Error())
+
+	failure := &errdetails.PreconditionFailure{}
+	for _, s := range err.Shortages {
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "STOCK",
+			Subject:     "offers/" + s.OfferID,
+			Description: fmt.Sprintf("запрошено %d, доступно %d", s.Requested, s.Available),
+		})
+	}
+
+	detailed, detailsErr := st.WithDetails(failure)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
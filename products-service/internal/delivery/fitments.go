@@ -0,0 +1,87 @@
+package delivery
+
+import (
+	"context"
+	"product-service/internal/models"
+	"product-service/internal/proto"
+
+	"go.uber.org/zap"
+)
+
+// ListMakes - обработка запроса на получение списка марок
+func (h *ProductHandler) ListMakes(ctx context.Context, req *proto.ListMakesRequest) (*proto.ListMakesResponse, error) {
+	h.logger.Info("Получен запрос на получение списка марок")
+
+	makes, err := h.service.Makes(ctx)
+	if err != nil {
+		h.logger.Error("Ошибка при получении списка марок", zap.Error(err))
+		return nil, toStatusError(err, "не удалось получить список марок")
+	}
+
+	return &proto.ListMakesResponse{Makes: makes}, nil
+}
+
+// ListModels - обработка запроса на получение списка моделей марки
+func (h *ProductHandler) ListModels(ctx context.Context, req *proto.ListModelsRequest) (*proto.ListModelsResponse, error) {
+	h.logger.Info("Получен запрос на получение списка моделей", zap.String("make", req.Make))
+
+	vehicleModels, err := h.service.Models(ctx, req.Make)
+	if err != nil {
+		h.logger.Error("Ошибка при получении списка моделей", zap.String("make", req.Make), zap.Error(err))
+		return nil, toStatusError(err, "не удалось получить список моделей")
+	}
+
+	return &proto.ListModelsResponse{Models: vehicleModels}, nil
+}
+
+// GetProductFitments - обработка запроса на получение применимости продукта
+func (h *ProductHandler) GetProductFitments(ctx context.Context, req *proto.GetProductFitmentsRequest) (*proto.ProductFitments, error) {
+	h.logger.Info("Получен запрос на получение применимости продукта", zap.String("product_id", req.ProductId))
+
+	fitments, err := h.service.Fitments(ctx, req.ProductId)
+	if err != nil {
+		h.logger.Error("Ошибка при получении применимости продукта", zap.String("product_id", req.ProductId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось получить применимость продукта")
+	}
+
+	return toProtoFitments(req.ProductId, fitments), nil
+}
+
+// SetProductFitments - обработка запроса на замену применимости продукта
+func (h *ProductHandler) SetProductFitments(ctx context.Context, req *proto.ProductFitments) (*proto.ProductFitments, error) {
+	h.logger.Info("Получен запрос на изменение применимости продукта", zap.String("product_id", req.ProductId), zap.Int("count", len(req.Fitments)))
+
+	fitments, err := h.service.SetFitments(ctx, req.ProductId, fromProtoFitments(req))
+	if err != nil {
+		h.logger.Error("Ошибка при изменении применимости продукта", zap.String("product_id", req.ProductId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось изменить применимость продукта")
+	}
+
+	h.logger.Info("Применимость продукта успешно изменена", zap.String("product_id", req.ProductId))
+	return toProtoFitments(req.ProductId, fitments), nil
+}
+
+// ListCompatibleProducts - обработка запроса на подбор продуктов, подходящих автомобилю
+func (h *ProductHandler) ListCompatibleProducts(ctx context.Context, req *proto.ListCompatibleProductsRequest) (*proto.GetProductsResponse, error) {
+	vehicle := vehicleFromProto(req.Vehicle)
+	if vehicle == nil {
+		vehicle = &models.Vehicle{}
+	}
+	h.logger.Info("Получен запрос на подбор продуктов", zap.String("make", vehicle.Make), zap.String("model", vehicle.Model), zap.Int("year", vehicle.Year))
+
+	offset := int(req.Offset)
+	if req.PageToken != "" {
+		var err error
+		if offset, err = decodePageToken(req.PageToken); err != nil {
+			return nil, toStatusError(err, "некорректный запрос")
+		}
+	}
+
+	products, total, err := h.service.Search(ctx, models.ProductFilter{Vehicle: vehicle}, offset, int(req.Limit))
+	if err != nil {
+		h.logger.Error("Ошибка при подборе продуктов", zap.Error(err))
+		return nil, toStatusError(err, "не удалось подобрать продукты")
+	}
+
+	return h.productsPage(products, total, offset)
+}
@@ -0,0 +1,187 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"product-service/internal/models"
+	"product-service/internal/proto"
+	"product-service/internal/usecase"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxImportFileSize - предельный размер прайс-листа
+const maxImportFileSize = 50 << 20
+
+// ImportProducts - прием прайс-листа потоком и запуск его обработки в фоне.
+// Первое сообщение содержит параметры импорта, следующие - части файла. В ответ возвращается задача в состоянии pending.
+func (h *ProductHandler) ImportProducts(stream proto.ProductService_ImportProductsServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.GetSpec() == nil {
+		return status.Error(codes.InvalidArgument, "первое сообщение потока должно содержать параметры импорта")
+	}
+	spec := fromProtoImportSpec(first.GetSpec())
+	if err := usecase.ValidateImportSpec(&spec); err != nil {
+		return toStatusError(err, "неверные параметры импорта")
+	}
+	h.logger.Info("Получен запрос на импорт прайс-листа", zap.String("supplier", spec.Supplier), zap.String("format", spec.Format))
+
+	// Файл сохраняется на диск целиком до запуска обработки, чтобы не держать поток клиента на время записи в базу
+	file, err := os.CreateTemp("", "import-*")
+	if err != nil {
+		h.logger.Error("Ошибка создания временного файла", zap.Error(err))
+		return status.Error(codes.Internal, "не удалось принять файл")
+	}
+	src := &tempFile{File: file}
+	started := false
+	defer func() {
+		if !started {
+			src.Close()
+		}
+	}()
+
+	var size int64
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		size += int64(len(msg.GetChunk()))
+		if size > maxImportFileSize {
+			return status.Errorf(codes.InvalidArgument, "файл больше %d МБ", maxImportFileSize>>20)
+		}
+		if _, err := file.Write(msg.GetChunk()); err != nil {
+			h.logger.Error("Ошибка записи временного файла", zap.Error(err))
+			return status.Error(codes.Internal, "не удалось принять файл")
+		}
+	}
+	if size == 0 {
+		return status.Error(codes.InvalidArgument, "файл пуст")
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return status.Error(codes.Internal, "не удалось принять файл")
+	}
+
+	job, err := h.service.CreateImportJob(stream.Context(), spec)
+	if err != nil {
+		h.logger.Error("Ошибка создания задачи импорта", zap.Error(err))
+		return toStatusError(err, "не удалось создать задачу импорта")
+	}
+
+	// Обработка переживает запрос: клиент узнает результат через GetImportJob
+	started = true
+	resp := toProtoImportJob(job)
+	go func() {
+		defer src.Close()
+		if err := h.service.RunImport(context.Background(), job, src); err != nil {
+			h.logger.Error("Импорт прайс-листа завершился ошибкой", zap.String("job_id", job.ID), zap.Error(err))
+			return
+		}
+		h.logger.Info("Импорт прайс-листа завершен", zap.String("job_id", job.ID),
+			zap.Int64("imported", job.Imported), zap.Int64("failed", job.Failed))
+	}()
+
+	h.logger.Info("Задача импорта создана", zap.String("job_id", job.ID), zap.Int64("size", size))
+	return stream.SendAndClose(resp)
+}
+
+// GetImportJob - обработка запроса на получение задачи импорта
+func (h *ProductHandler) GetImportJob(ctx context.Context, req *proto.GetImportJobRequest) (*proto.ImportJob, error) {
+	job, err := h.service.ImportJob(ctx, req.Id)
+	if errors.Is(err, models.ErrImportJobNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("Ошибка получения задачи импорта", zap.String("id", req.Id), zap.Error(err))
+		return nil, toStatusError(err, "не удалось получить задачу импорта")
+	}
+	return toProtoImportJob(job), nil
+}
+
+// tempFile - временный файл, удаляемый при закрытии
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	return errors.Join(f.File.Close(), os.Remove(f.Name()))
+}
+
+// importFormats - соответствие форматов прайс-листа значениям gRPC
+var importFormats = map[proto.ImportFormat]string{
+	proto.ImportFormat_IMPORT_FORMAT_CSV:  models.ImportFormatCSV,
+	proto.ImportFormat_IMPORT_FORMAT_XLSX: models.ImportFormatXLSX,
+}
+
+// importStatuses - соответствие состояний задачи импорта значениям gRPC
+var importStatuses = map[string]proto.ImportStatus{
+	models.ImportPending: proto.ImportStatus_IMPORT_STATUS_PENDING,
+	models.ImportRunning: proto.ImportStatus_IMPORT_STATUS_RUNNING,
+	models.ImportDone:    proto.ImportStatus_IMPORT_STATUS_DONE,
+	models.ImportFailed:  proto.ImportStatus_IMPORT_STATUS_FAILED,
+}
+
+func fromProtoImportSpec(spec *proto.ImportSpec) models.ImportSpec {
+	m := spec.GetMapping()
+	result := models.ImportSpec{
+		Supplier: spec.GetSupplier(),
+		Format:   importFormats[spec.GetFormat()],
+		Mapping: models.ColumnMapping{
+			Article:      m.GetArticle(),
+			Brand:        m.GetBrand(),
+			Name:         m.GetName(),
+			Price:        m.GetPrice(),
+			Quantity:     m.GetQuantity(),
+			DeliveryDays: m.GetDeliveryDays(),
+			Description:  m.GetDescription(),
+			Category:     m.GetCategory(),
+		},
+		HeaderRow: int(spec.GetHeaderRow()),
+		Sheet:     spec.GetSheet(),
+		Currency:  spec.GetCurrency(),
+	}
+	if d := []rune(spec.GetDelimiter()); len(d) == 1 {
+		result.Delimiter = d[0]
+	}
+	return result
+}
+
+func toProtoImportJob(job *models.ImportJob) *proto.ImportJob {
+	resp := &proto.ImportJob{
+		Id:              job.ID,
+		Supplier:        job.Spec.Supplier,
+		Status:          importStatuses[job.Status],
+		Rows:            job.Rows,
+		Imported:        job.Imported,
+		Failed:          job.Failed,
+		ProductsCreated: job.ProductsCreated,
+		OffersInserted:  job.OffersInserted,
+		OffersUpdated:   job.OffersUpdated,
+		ErrorsTruncated: job.ErrorsTruncated,
+		Error:           job.Error,
+		CreatedAt:       timestamppb.New(job.CreatedAt),
+	}
+	for format, name := range importFormats {
+		if name == job.Spec.Format {
+			resp.Format = format
+		}
+	}
+	for _, e := range job.Errors {
+		resp.Errors = append(resp.Errors, &proto.ImportRowError{Row: int32(e.Row), Column: e.Column, Message: e.Message})
+	}
+	if job.FinishedAt != nil {
+		resp.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	return resp
+}
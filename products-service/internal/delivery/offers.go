@@ -0,0 +1,49 @@
+package delivery
+
+import (
+	"context"
+	"product-service/internal/models"
+	"product-service/internal/proto"
+
+	"go.uber.org/zap"
+)
+
+// UpsertOffers - обработка запроса на загрузку предложений поставщиков
+func (h *ProductHandler) UpsertOffers(ctx context.Context, req *proto.UpsertOffersRequest) (*proto.UpsertOffersResponse, error) {
+	h.logger.Info("Получен запрос на загрузку предложений", zap.Int("count", len(req.Offers)))
+
+	offers := make([]models.Offer, 0, len(req.Offers))
+	for _, offer := range req.Offers {
+		offers = append(offers, fromProtoOffer(offer))
+	}
+
+	inserted, updated, err := h.service.UpsertOffers(ctx, offers)
+	if err != nil {
+		h.logger.Error("Ошибка при загрузке предложений", zap.Error(err))
+		return nil, toStatusError(err, "не удалось загрузить предложения")
+	}
+
+	h.logger.Info("Предложения загружены", zap.Int64("inserted", inserted), zap.Int64("updated", updated))
+	return &proto.UpsertOffersResponse{Inserted: inserted, Updated: updated}, nil
+}
+
+// GetProductWithOffers - обработка запроса на получение продукта со всеми предложениями
+func (h *ProductHandler) GetProductWithOffers(ctx context.Context, req *proto.GetProductWithOffersRequest) (*proto.ProductWithOffers, error) {
+	h.logger.Info("Получен запрос на получение предложений по продукту", zap.String("product_id", req.ProductId))
+
+	result, err := h.service.ProductWithOffers(ctx, req.ProductId, offerSortFromProto(req.Sort))
+	if err != nil {
+		h.logger.Error("Ошибка при получении предложений по продукту", zap.String("product_id", req.ProductId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось получить предложения по продукту")
+	}
+
+	product, err := h.productResponse(result.Product)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ProductWithOffers{Product: product}
+	for i := range result.Offers {
+		resp.Offers = append(resp.Offers, toProtoOffer(&result.Offers[i]))
+	}
+	return resp, nil
+}
@@ -0,0 +1,31 @@
+package delivery
+
+import (
+	"encoding/base64"
+	"product-service/internal/models"
+	"strconv"
+)
+
+// encodePageToken - токен страницы, начинающейся с offset
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodePageToken - получение offset из токена страницы
+func decodePageToken(token string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, invalidPageToken()
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, invalidPageToken()
+	}
+	return offset, nil
+}
+
+func invalidPageToken() error {
+	verr := &models.ValidationError{}
+	verr.Add("page_token", "некорректный токен страницы")
+	return verr
+}
@@ -7,8 +7,6 @@ import (
 	"product-service/internal/usecase"
 
 	"go.uber.org/zap" // Импортируем zap для логирования
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 var _ proto.ProductServiceServer = (*ProductHandler)(nil)
@@ -30,30 +28,21 @@ func (h *ProductHandler) CreateProduct(ctx context.Context, req *proto.Product)
 	h.logger.Info("Получен запрос на создание продукта", zap.String("name", req.Name))
 
 	// Создание модели продукта из запроса
-	product := &models.Product{
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       req.Price,
-	}
+	product := fromProtoProduct(req)
 
 	// Вызов бизнес-логики для создания продукта
 	err := h.service.Create(ctx, product)
 	if err != nil {
 		// Логирование ошибки и возврат ошибки с соответствующим кодом
 		h.logger.Error("Ошибка при создании продукта", zap.String("name", req.Name), zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось создать продукт: %v", err)
+		return nil, toStatusError(err, "не удалось создать продукт")
 	}
 
 	// Логирование успешного создания
 	h.logger.Info("Продукт успешно создан", zap.String("id", product.ID), zap.String("name", product.Name))
 
 	// Возвращаем ответ с созданным продуктом
-	return &proto.Product{
-		Id:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-	}, nil
+	return h.productResponse(product)
 }
 
 // GetProductByID - обработка запроса на получение продукта по ID
@@ -63,52 +52,94 @@ func (h *ProductHandler) GetProductByID(ctx context.Context, req *proto.GetProdu
 	// Получаем продукт по ID
 	product, err := h.service.GetByID(ctx, req.Id)
 	if err != nil {
-		// Логирование ошибки и возврат ошибки с кодом NotFound, если продукт не найден
+		// Логирование ошибки и возврат ошибки с соответствующим кодом
 		h.logger.Error("Продукт не найден", zap.String("id", req.Id), zap.Error(err))
-		return nil, status.Errorf(codes.NotFound, "не удалось получить продукт с ID %s: %v", req.Id, err)
+		return nil, toStatusError(err, "не удалось получить продукт")
 	}
 
 	// Логирование успешного получения продукта
 	h.logger.Info("Продукт успешно найден", zap.String("id", product.ID))
 
 	// Возвращаем ответ с найденным продуктом
-	return &proto.Product{
-		Id:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-	}, nil
+	return h.productResponse(product)
 }
 
 // GetProducts - обработка запроса на получение списка продуктов
 func (h *ProductHandler) GetProducts(ctx context.Context, req *proto.GetProductsRequest) (*proto.GetProductsResponse, error) {
-	h.logger.Info("Получен запрос на получение списка продуктов")
+	h.logger.Info("Получен запрос на получение списка продуктов", zap.Int32("offset", req.Offset), zap.Int32("limit", req.Limit))
+
+	offset := int(req.Offset)
+	if req.PageToken != "" {
+		var err error
+		if offset, err = decodePageToken(req.PageToken); err != nil {
+			return nil, toStatusError(err, "некорректный запрос")
+		}
+	}
 
-	// Получаем все продукты
-	products, err := h.service.List(ctx)
+	// Получаем страницу продуктов
+	products, total, err := h.service.List(ctx, offset, int(req.Limit))
 	if err != nil {
 		// Логирование ошибки и возврат ошибки с соответствующим кодом
 		h.logger.Error("Ошибка при получении списка продуктов", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось получить список продуктов: %v", err)
+		return nil, toStatusError(err, "не удалось получить список продуктов")
 	}
 
 	// Логирование успешного получения списка продуктов
 	h.logger.Info("Список продуктов успешно получен", zap.Int("count", len(products)))
 
-	// Формируем ответ с продуктами
-	var productList []*proto.Product
-	for _, product := range products {
-		productList = append(productList, &proto.Product{
-			Id:          product.ID,
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       product.Price,
-		})
+	return h.productsPage(products, total, offset)
+}
+
+// SearchProducts - обработка запроса на поиск продуктов
+func (h *ProductHandler) SearchProducts(ctx context.Context, req *proto.SearchProductsRequest) (*proto.GetProductsResponse, error) {
+	h.logger.Info("Получен запрос на поиск продуктов", zap.String("query", req.Query), zap.String("category", req.Category))
+
+	offset := int(req.Offset)
+	if req.PageToken != "" {
+		var err error
+		if offset, err = decodePageToken(req.PageToken); err != nil {
+			return nil, toStatusError(err, "некорректный запрос")
+		}
+	}
+
+	filter := models.ProductFilter{
+		Query:      req.Query,
+		Category:   req.Category,
+		MinPrice:   req.MinPrice,
+		MaxPrice:   req.MaxPrice,
+		Attributes: req.Attributes,
+		Vehicle:    vehicleFromProto(req.Vehicle),
+		Sort:       sortFromProto(req.Sort),
+	}
+
+	products, total, err := h.service.Search(ctx, filter, offset, int(req.Limit))
+	if err != nil {
+		h.logger.Error("Ошибка при поиске продуктов", zap.Error(err))
+		return nil, toStatusError(err, "не удалось выполнить поиск продуктов")
+	}
+
+	h.logger.Info("Поиск продуктов выполнен", zap.Int("count", len(products)), zap.Int64("total", total))
+
+	return h.productsPage(products, total, offset)
+}
+
+// productsPage - формирование страницы продуктов с токеном следующей страницы
+func (h *ProductHandler) productsPage(products []models.Product, total int64, offset int) (*proto.GetProductsResponse, error) {
+	productList, err := h.protoProducts(products)
+	if err != nil {
+		return nil, err
+	}
+
+	// Токен следующей страницы выдается, только если продукты еще остались
+	var nextPageToken string
+	if next := offset + len(products); len(products) > 0 && int64(next) < total {
+		nextPageToken = encodePageToken(next)
 	}
 
-	// Возвращаем ответ с продуктами
 	return &proto.GetProductsResponse{
-		Products: productList,
+		Products:      productList,
+		Total:         total,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -116,32 +147,19 @@ func (h *ProductHandler) GetProducts(ctx context.Context, req *proto.GetProducts
 func (h *ProductHandler) UpdateProduct(ctx context.Context, req *proto.Product) (*proto.Product, error) {
 	h.logger.Info("Получен запрос на обновление продукта", zap.String("id", req.Id))
 
-	// Создание модели продукта из запроса
-	product := &models.Product{
-		ID:          req.Id,
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       req.Price,
-	}
-
 	// Вызов бизнес-логики для обновления продукта
-	err := h.service.Update(ctx, product)
+	product, err := h.service.Update(ctx, fromProtoProduct(req))
 	if err != nil {
 		// Логирование ошибки и возврат ошибки с соответствующим кодом
 		h.logger.Error("Ошибка при обновлении продукта", zap.String("id", req.Id), zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось обновить продукт: %v", err)
+		return nil, toStatusError(err, "не удалось обновить продукт")
 	}
 
 	// Логирование успешного обновления
 	h.logger.Info("Продукт успешно обновлен", zap.String("id", req.Id))
 
 	// Возвращаем ответ с обновленным продуктом
-	return &proto.Product{
-		Id:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-	}, nil
+	return h.productResponse(product)
 }
 
 // DeleteProduct - обработка запроса на удаление продукта
@@ -153,7 +171,7 @@ func (h *ProductHandler) DeleteProduct(ctx context.Context, req *proto.DeletePro
 	if err != nil {
 		// Логирование ошибки и возврат ошибки с соответствующим кодом
 		h.logger.Error("Ошибка при удалении продукта", zap.String("id", req.Id), zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось удалить продукт с ID %s: %v", req.Id, err)
+		return nil, toStatusError(err, "не удалось удалить продукт")
 	}
 
 	// Логирование успешного удаления
@@ -164,3 +182,13 @@ func (h *ProductHandler) DeleteProduct(ctx context.Context, req *proto.DeletePro
 		Success: true,
 	}, nil
 }
+
+// productResponse - формирование ответа с продуктом
+func (h *ProductHandler) productResponse(product *models.Product) (*proto.Product, error) {
+	resp, err := toProtoProduct(product)
+	if err != nil {
+		h.logger.Error("Ошибка преобразования продукта", zap.String("id", product.ID), zap.Error(err))
+		return nil, toStatusError(err, "не удалось сформировать ответ")
+	}
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package delivery
+
+import (
+	"context"
+	"product-service/internal/models"
+	"product-service/internal/proto"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReserveStock - обработка запроса на резерв остатков под заказ
+func (h *ProductHandler) ReserveStock(ctx context.Context, req *proto.ReserveStockRequest) (*proto.Reservation, error) {
+	h.logger.Info("Получен запрос на резерв остатков", zap.String("order_id", req.OrderId), zap.Int("items", len(req.Items)))
+
+	items := make([]models.ReservationItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, models.ReservationItem{
+			ProductID: item.GetProductId(),
+			OfferID:   item.GetOfferId(),
+			Quantity:  int(item.GetQuantity()),
+		})
+	}
+
+	reservation, err := h.service.ReserveStock(ctx, req.OrderId, items, time.Duration(req.TtlSeconds)*time.Second)
+	if err != nil {
+		h.logger.Error("Ошибка резерва остатков", zap.String("order_id", req.OrderId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось зарезервировать остатки")
+	}
+	return toProtoReservation(reservation), nil
+}
+
+// ConfirmReservation - обработка запроса на списание зарезервированных остатков
+func (h *ProductHandler) ConfirmReservation(ctx context.Context, req *proto.ReservationRequest) (*proto.Reservation, error) {
+	h.logger.Info("Получен запрос на подтверждение резерва", zap.String("order_id", req.OrderId))
+
+	reservation, err := h.service.ConfirmReservation(ctx, req.OrderId)
+	if err != nil {
+		h.logger.Error("Ошибка подтверждения резерва", zap.String("order_id", req.OrderId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось подтвердить резерв")
+	}
+	return toProtoReservation(reservation), nil
+}
+
+// ReleaseReservation - обработка запроса на снятие резерва
+func (h *ProductHandler) ReleaseReservation(ctx context.Context, req *proto.ReservationRequest) (*proto.Reservation, error) {
+	h.logger.Info("Получен запрос на снятие резерва", zap.String("order_id", req.OrderId))
+
+	reservation, err := h.service.ReleaseReservation(ctx, req.OrderId)
+	if err != nil {
+		h.logger.Error("Ошибка снятия резерва", zap.String("order_id", req.OrderId), zap.Error(err))
+		return nil, toStatusError(err, "не удалось снять резерв")
+	}
+	return toProtoReservation(reservation), nil
+}
+
+// GetReservation - обработка запроса на получение резерва
+func (h *ProductHandler) GetReservation(ctx context.Context, req *proto.ReservationRequest) (*proto.Reservation, error) {
+	reservation, err := h.service.GetReservation(ctx, req.OrderId)
+	if err != nil {
+		return nil, toStatusError(err, "не удалось получить резерв")
+	}
+	return toProtoReservation(reservation), nil
+}
+
+// reservationStatuses - соответствие состояний резерва значениям enum
+var reservationStatuses = map[string]proto.ReservationStatus{
+	models.ReservationActive:    proto.ReservationStatus_RESERVATION_STATUS_ACTIVE,
+	models.ReservationConfirmed: proto.ReservationStatus_RESERVATION_STATUS_CONFIRMED,
+	models.ReservationReleased:  proto.ReservationStatus_RESERVATION_STATUS_RELEASED,
+	models.ReservationExpired:   proto.ReservationStatus_RESERVATION_STATUS_EXPIRED,
+}
+
+func toProtoReservation(reservation *models.Reservation) *proto.Reservation {
+	resp := &proto.Reservation{
+		OrderId:   reservation.ID,
+		Status:    reservationStatuses[reservation.Status],
+		ExpiresAt: timestamppb.New(reservation.ExpiresAt),
+		CreatedAt: timestamppb.New(reservation.CreatedAt),
+		UpdatedAt: timestamppb.New(reservation.UpdatedAt),
+	}
+	for _, item := range reservation.Items {
+		resp.Items = append(resp.Items, &proto.ReservationItem{
+			ProductId: item.ProductID,
+			OfferId:   item.OfferID,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+	return resp
+}
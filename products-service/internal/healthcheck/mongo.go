@@ -0,0 +1,62 @@
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// checkInterval - период проверки соединения с MongoDB
+	checkInterval = 5 * time.Second
+	// pingTimeout - максимальное время ожидания ответа MongoDB
+	pingTimeout = 2 * time.Second
+)
+
+// WatchMongo - периодически проверяет соединение с MongoDB и выставляет статус
+// gRPC health для всего сервера ("") и перечисленных сервисов.
+// Работает до отмены ctx.
+func WatchMongo(ctx context.Context, client *mongo.Client, server *health.Server, logger *zap.Logger, services ...string) {
+	services = append([]string{""}, services...)
+	current := healthpb.HealthCheckResponse_UNKNOWN
+
+	check := func() {
+		pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+		defer cancel()
+
+		next := healthpb.HealthCheckResponse_SERVING
+		if err := client.Ping(pingCtx, nil); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			next = healthpb.HealthCheckResponse_NOT_SERVING
+			logger.Warn("MongoDB недоступна", zap.Error(err))
+		}
+		if next == current {
+			return
+		}
+
+		logger.Info("Изменен статус сервера", zap.String("status", next.String()))
+		for _, service := range services {
+			server.SetServingStatus(service, next)
+		}
+		current = next
+	}
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	check()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			check()
+		}
+	}
+}
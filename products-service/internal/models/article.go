@@ -0,0 +1,40 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeArticle - приведение артикула к виду для поиска: остаются только буквы и цифры в верхнем регистре.
+// Так "04152-YZZA1", "04152 yzza1" и "04152.YZZA1" считаются одним артикулом.
+func NormalizeArticle(article string) string {
+	var b strings.Builder
+	b.Grow(len(article))
+	for _, r := range article {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	return b.String()
+}
+
+// CrossReference - взаимозаменяемость двух артикулов, действует в обе стороны.
+// Пустой производитель означает любого производителя с этим артикулом.
+type CrossReference struct {
+	ID                      string `bson:"_id,omitempty"`
+	Brand                   string `bson:"brand"`
+	Article                 string `bson:"article"`
+	ArticleNormalized       string `bson:"article_normalized"`
+	AnalogBrand             string `bson:"analog_brand"`
+	AnalogArticle           string `bson:"analog_article"`
+	AnalogArticleNormalized string `bson:"analog_article_normalized"`
+}
+
+// ArticleMatch - результат поиска по артикулу
+type ArticleMatch struct {
+	ArticleNormalized string
+	// Exact - продукты с искомым артикулом
+	Exact []Product
+	// Analogs - продукты, заменяющие искомый по таблице кроссов
+	Analogs []Product
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNotFound - продукт не найден
+var ErrNotFound = errors.New("продукт не найден")
+
+// FieldViolation - нарушение ограничения на поле запроса
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError - ошибка валидации входных данных со списком нарушений по полям
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// Add - добавление нарушения для поля
+func (e *ValidationError) Add(field, description string) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Description: description})
+}
+
+// Err - ошибка, если найдено хотя бы одно нарушение, иначе nil
+func (e *ValidationError) Err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, v.Field+": "+v.Description)
+	}
+	return "невалидные данные: " + strings.Join(parts, "; ")
+}
@@ -0,0 +1,26 @@
+package models
+
+// Fitment - применимость продукта к автомобилям одной модели
+type Fitment struct {
+	ID         string `bson:"_id,omitempty"`
+	ProductID  string `bson:"product_id"`
+	Make       string `bson:"make"`
+	Model      string `bson:"model"`
+	Generation string `bson:"generation,omitempty"`
+	// YearFrom, YearTo - границы годов выпуска включительно, 0 - без ограничения
+	YearFrom int `bson:"year_from"`
+	YearTo   int `bson:"year_to"`
+	// EngineCode, Body - пустое значение означает любой двигатель или кузов
+	EngineCode string `bson:"engine_code"`
+	Body       string `bson:"body"`
+}
+
+// Vehicle - автомобиль, для которого подбираются запчасти
+type Vehicle struct {
+	Make  string
+	Model string
+	// Year - год выпуска, 0 - любой
+	Year       int
+	EngineCode string
+	Body       string
+}
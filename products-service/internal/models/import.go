@@ -0,0 +1,95 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrImportJobNotFound - задача импорта не найдена
+var ErrImportJobNotFound = errors.New("задача импорта не найдена")
+
+// Форматы прайс-листов
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatXLSX = "xlsx"
+)
+
+// Состояния задачи импорта
+const (
+	ImportPending = "pending"
+	ImportRunning = "running"
+	ImportDone    = "done"
+	ImportFailed  = "failed"
+)
+
+// MaxImportRowErrors - сколько ошибок строк сохраняется в отчете задачи
+const MaxImportRowErrors = 1000
+
+// ColumnMapping - столбцы прайс-листа: название из заголовка, номер столбца с 1 или буква (A, B, ...).
+// Пустое значение - столбца нет.
+type ColumnMapping struct {
+	Article      string `bson:"article"`
+	Brand        string `bson:"brand,omitempty"`
+	Name         string `bson:"name,omitempty"`
+	Price        string `bson:"price"`
+	Quantity     string `bson:"quantity,omitempty"`
+	DeliveryDays string `bson:"delivery_days,omitempty"`
+	Description  string `bson:"description,omitempty"`
+	Category     string `bson:"category,omitempty"`
+}
+
+// ImportSpec - параметры импорта прайс-листа
+type ImportSpec struct {
+	Supplier string        `bson:"supplier"`
+	Format   string        `bson:"format"`
+	Mapping  ColumnMapping `bson:"mapping"`
+	// HeaderRow - номер строки заголовка, начиная с 1; 0 - заголовка нет
+	HeaderRow int `bson:"header_row"`
+	// Sheet - лист XLSX, пустой - первый лист
+	Sheet string `bson:"sheet,omitempty"`
+	// Delimiter - разделитель CSV, 0 - определяется по первой строке
+	Delimiter rune `bson:"delimiter,omitempty"`
+	// Currency - валюта цен, по умолчанию DefaultCurrency
+	Currency string `bson:"currency"`
+}
+
+// ImportRowError - ошибка в строке прайс-листа
+type ImportRowError struct {
+	// Row - номер строки в файле, начиная с 1
+	Row     int    `bson:"row"`
+	Column  string `bson:"column,omitempty"`
+	Message string `bson:"message"`
+}
+
+// ImportJob - задача импорта прайс-листа с отчетом по строкам
+type ImportJob struct {
+	ID     string     `bson:"_id"`
+	Spec   ImportSpec `bson:"spec"`
+	Status string     `bson:"status"`
+
+	// Rows - обработано строк данных, Imported - загружено, Failed - с ошибками
+	Rows            int64 `bson:"rows"`
+	Imported        int64 `bson:"imported"`
+	Failed          int64 `bson:"failed"`
+	ProductsCreated int64 `bson:"products_created"`
+	OffersInserted  int64 `bson:"offers_inserted"`
+	OffersUpdated   int64 `bson:"offers_updated"`
+
+	// Errors - первые MaxImportRowErrors ошибок строк
+	Errors          []ImportRowError `bson:"errors"`
+	ErrorsTruncated bool             `bson:"errors_truncated"`
+	// Error - ошибка, остановившая импорт целиком
+	Error string `bson:"error,omitempty"`
+
+	CreatedAt  time.Time  `bson:"created_at"`
+	FinishedAt *time.Time `bson:"finished_at,omitempty"`
+}
+
+// AddRowError - добавление ошибки строки в отчет
+func (j *ImportJob) AddRowError(e ImportRowError) {
+	if len(j.Errors) >= MaxImportRowErrors {
+		j.ErrorsTruncated = true
+		return
+	}
+	j.Errors = append(j.Errors, e)
+}
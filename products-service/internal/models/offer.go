@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// Сортировка предложений поставщиков
+const (
+	OfferSortPrice    = "price"
+	OfferSortDelivery = "delivery"
+)
+
+// Состояние запчасти в объявлении
+const (
+	ConditionNew      = "new"
+	ConditionUsed     = "used"
+	ConditionContract = "contract"
+)
+
+// DefaultCurrency - валюта предложения, если поставщик ее не указал
+const DefaultCurrency = "RUB"
+
+// Offer - предложение поставщика по продукту.
+// Поставщик и продукт однозначно определяют предложение: повторная загрузка обновляет его.
+type Offer struct {
+	ID        string  `bson:"_id,omitempty"`
+	ProductID string  `bson:"product_id"`
+	Supplier  string  `bson:"supplier"`
+	Price     float32 `bson:"price"`
+	// Currency - код валюты ISO 4217
+	Currency string `bson:"currency"`
+	// Quantity - количество у поставщика, Reserved - зарезервировано под неоплаченные заказы.
+	// Загрузка предложений меняет только Quantity, резервы ведет сервис.
+	Quantity int `bson:"quantity"`
+	Reserved int `bson:"reserved,omitempty"`
+	// HeldBy - заказы, под которые удержано количество; повторное удержание или возврат по заказу ничего не меняют
+	HeldBy       []string  `bson:"held_by,omitempty"`
+	DeliveryDays int       `bson:"delivery_days"`
+	UpdatedAt    time.Time `bson:"updated_at"`
+
+	// Данные объявления для предложений, собранных агрегатором с досок объявлений
+	URL   string `bson:"url,omitempty"`
+	Title string `bson:"title,omitempty"`
+	// Condition - new, used или contract
+	Condition string `bson:"condition,omitempty"`
+	ImageURL  string `bson:"image_url,omitempty"`
+	Seller    string `bson:"seller,omitempty"`
+	Location  string `bson:"location,omitempty"`
+}
+
+// Available - количество, доступное для заказа
+func (o Offer) Available() int {
+	return max(o.Quantity-o.Reserved, 0)
+}
+
+// InStock - есть ли товар у поставщика с учетом резервов
+func (o Offer) InStock() bool {
+	return o.Available() > 0
+}
+
+// ProductWithOffers - продукт со всеми предложениями поставщиков
+type ProductWithOffers struct {
+	Product *Product
+	Offers  []Offer
+}
@@ -1,8 +1,26 @@
 package models
 
+import "time"
+
 type Product struct {
-	ID          string  `bson:"_id,omitempty"`
-	Name        string  `bson:"name"`
-	Description string  `bson:"description"`
-	Price       float32 `bson:"price"`
+	ID          string         `bson:"_id,omitempty"`
+	Name        string         `bson:"name"`
+	Description string         `bson:"description"`
+	Price       float32        `bson:"price"`
+	Category    string         `bson:"category"`
+	Attributes  map[string]any `bson:"attributes,omitempty"`
+
+	// Brand, Article - производитель и артикул запчасти
+	Brand   string `bson:"brand,omitempty"`
+	Article string `bson:"article,omitempty"`
+	// ArticleNormalized - артикул для поиска, см. NormalizeArticle
+	ArticleNormalized string `bson:"article_normalized,omitempty"`
+
+	// BestOffer, OfferCount - самое дешевое предложение в наличии и количество предложений в наличии.
+	// Пересчитываются при загрузке предложений, чтобы списки продуктов не обращались к коллекции предложений.
+	BestOffer  *Offer `bson:"best_offer,omitempty"`
+	OfferCount int    `bson:"offer_count,omitempty"`
+
+	CreatedAt time.Time `bson:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at"`
 }
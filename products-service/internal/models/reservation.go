@@ -0,0 +1,99 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Состояния резерва
+const (
+	ReservationActive    = "active"
+	ReservationConfirmed = "confirmed"
+	ReservationReleased  = "released"
+	ReservationExpired   = "expired"
+)
+
+// ErrReservationNotFound - резерв для заказа не найден
+var ErrReservationNotFound = errors.New("резерв не найден")
+
+// ErrReservationInProgress - резерв для заказа еще создается: удержаны не все позиции, запрос нужно повторить
+var ErrReservationInProgress = errors.New("резерв для заказа еще создается, повторите запрос")
+
+// Reservation - резерв остатков предложений под заказ, ID совпадает с ID заказа
+type Reservation struct {
+	ID        string            `bson:"_id"`
+	Items     []ReservationItem `bson:"items"`
+	Status    string            `bson:"status"`
+	ExpiresAt time.Time         `bson:"expires_at"`
+	CreatedAt time.Time         `bson:"created_at"`
+	UpdatedAt time.Time         `bson:"updated_at"`
+}
+
+// Complete - резерв активен и удержаны все позиции
+func (r *Reservation) Complete() bool {
+	if r.Status != ReservationActive {
+		return false
+	}
+	for _, item := range r.Items {
+		if !item.Held {
+			return false
+		}
+	}
+	return true
+}
+
+// Unsettled - резерв закрыт, но часть позиций еще может быть удержана в предложениях
+func (r *Reservation) Unsettled() bool {
+	if r.Status == ReservationActive {
+		return false
+	}
+	for _, item := range r.Items {
+		if item.Holding || item.Held {
+			return true
+		}
+	}
+	return false
+}
+
+// ReservationItem - резервируемое количество по предложению
+type ReservationItem struct {
+	ProductID string `bson:"product_id"`
+	OfferID   string `bson:"offer_id"`
+	Quantity  int    `bson:"quantity"`
+	// Holding - удержание начато: отмечается до удержания, поэтому прерванное удержание можно отменить.
+	// Held - количество удержано в предложении. Оба признака снимаются после возврата или списания количества,
+	// поэтому у закрытого резерва с отмеченными позициями возврат или списание еще не завершены
+	Holding bool `bson:"holding,omitempty"`
+	Held    bool `bson:"held"`
+}
+
+// StockShortage - нехватка остатка по предложению
+type StockShortage struct {
+	OfferID   string
+	Requested int
+	Available int
+}
+
+// InsufficientStockError - остатков не хватает для резерва
+type InsufficientStockError struct {
+	Shortages []StockShortage
+}
+
+func (e *InsufficientStockError) Error() string {
+	parts := make([]string, 0, len(e.Shortages))
+	for _, s := range e.Shortages {
+		parts = append(parts, fmt.Sprintf("предложение %s: запрошено %d, доступно %d", s.OfferID, s.Requested, s.Available))
+	}
+	return "недостаточно товара: " + strings.Join(parts, "; ")
+}
+
+// ReservationStateError - операция недоступна в текущем состоянии резерва
+type ReservationStateError struct {
+	Status string
+}
+
+func (e *ReservationStateError) Error() string {
+	return fmt.Sprintf("резерв в состоянии %s", e.Status)
+}
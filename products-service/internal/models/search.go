@@ -0,0 +1,25 @@
+package models
+
+// Порядок сортировки результатов поиска
+const (
+	SortRelevance = "relevance"
+	SortPriceAsc  = "price_asc"
+	SortPriceDesc = "price_desc"
+	SortNewest    = "newest"
+)
+
+// ProductFilter - параметры поиска продуктов
+type ProductFilter struct {
+	// Query - полнотекстовый запрос по названию и описанию
+	Query    string
+	Category string
+	MinPrice *float32
+	MaxPrice *float32
+	// Attributes - точное совпадение значений характеристик
+	Attributes map[string]string
+	// Vehicle - только продукты, подходящие автомобилю
+	Vehicle *Vehicle
+	// ProductIDs - ограничение выборки списком продуктов, nil - без ограничения
+	ProductIDs []string
+	Sort       string
+}
@@ -0,0 +1,137 @@
+// Package pricelist - построчное чтение прайс-листов поставщиков в форматах CSV и XLSX
+package pricelist
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"product-service/internal/models"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Reader - построчное чтение таблицы; по окончании данных Next возвращает io.EOF
+type Reader interface {
+	Next() ([]string, error)
+	Close() error
+}
+
+// Open - построчное чтение прайс-листа в заданном формате.
+// CSV читается потоково; XLSX - zip-архив, он загружается целиком, а строки листа отдаются по одной.
+func Open(r io.Reader, spec models.ImportSpec) (Reader, error) {
+	switch spec.Format {
+	case models.ImportFormatCSV:
+		return newCSVReader(r, spec.Delimiter)
+	case models.ImportFormatXLSX:
+		return newXLSXReader(r, spec.Sheet)
+	}
+	return nil, fmt.Errorf("неизвестный формат прайс-листа %q", spec.Format)
+}
+
+// csvReader - чтение CSV с разделителем ",", ";" или табуляцией
+type csvReader struct {
+	r *csv.Reader
+}
+
+func newCSVReader(r io.Reader, delimiter rune) (*csvReader, error) {
+	br := bufio.NewReader(r)
+	// Excel сохраняет CSV с BOM, который иначе попадет в название первого столбца
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte{0xEF, 0xBB, 0xBF}) {
+		br.Discard(3)
+	}
+	if delimiter == 0 {
+		delimiter = detectDelimiter(br)
+	}
+
+	cr := csv.NewReader(br)
+	cr.Comma = delimiter
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	return &csvReader{r: cr}, nil
+}
+
+// detectDelimiter - самый частый из допустимых разделителей в первой строке, по умолчанию запятая
+func detectDelimiter(br *bufio.Reader) rune {
+	line, _ := br.Peek(br.Size())
+	if i := bytes.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+	best, bestCount := ',', 0
+	for _, d := range []rune{',', ';', '\t'} {
+		if n := bytes.Count(line, []byte(string(d))); n > bestCount {
+			best, bestCount = d, n
+		}
+	}
+	return best
+}
+
+func (c *csvReader) Next() ([]string, error) {
+	return c.r.Read()
+}
+
+func (c *csvReader) Close() error {
+	return nil
+}
+
+// xlsxReader - чтение листа XLSX
+type xlsxReader struct {
+	file *excelize.File
+	rows *excelize.Rows
+}
+
+func newXLSXReader(r io.Reader, sheet string) (*xlsxReader, error) {
+	file, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось открыть файл XLSX: %w", err)
+	}
+	if sheet == "" {
+		sheet = file.GetSheetName(0)
+	}
+	rows, err := file.Rows(sheet)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("лист %q: %w", sheet, err)
+	}
+	return &xlsxReader{file: file, rows: rows}, nil
+}
+
+func (x *xlsxReader) Next() ([]string, error) {
+	if !x.rows.Next() {
+		if err := x.rows.Error(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return x.rows.Columns()
+}
+
+func (x *xlsxReader) Close() error {
+	return errors.Join(x.rows.Close(), x.file.Close())
+}
+
+// ColumnIndex - номер столбца (с 0) по ссылке из сопоставления: название из заголовка (без учета регистра),
+// номер с 1 или буква столбца. Название ищется первым, поэтому столбец с заголовком "A" находится по заголовку.
+func ColumnIndex(ref string, header []string) (int, error) {
+	ref = strings.TrimSpace(ref)
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), ref) {
+			return i, nil
+		}
+	}
+	if n, err := strconv.Atoi(ref); err == nil && n > 0 {
+		return n - 1, nil
+	}
+	if n, err := excelize.ColumnNameToNumber(strings.ToUpper(ref)); err == nil && len(ref) <= 3 {
+		return n - 1, nil
+	}
+	if header == nil {
+		return 0, fmt.Errorf("столбец %q: без заголовка ожидался номер или буква столбца", ref)
+	}
+	return 0, fmt.Errorf("столбец %q не найден в заголовке", ref)
+}
@@ -0,0 +1,147 @@
+package pricelist
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"product-service/internal/models"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// readAll - все строки прайс-листа
+func readAll(t *testing.T, r Reader) [][]string {
+	t.Helper()
+	defer r.Close()
+
+	var rows [][]string
+	for {
+		row, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return rows
+		}
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		delimiter rune
+		want      [][]string
+	}{
+		{
+			name: "запятая по умолчанию",
+			data: "article,price\nOC90,100\n",
+			want: [][]string{{"article", "price"}, {"OC90", "100"}},
+		},
+		{
+			name: "точка с запятой по первой строке",
+			data: "article;price;comment\nOC90;1,5;a,b,c\n",
+			want: [][]string{{"article", "price", "comment"}, {"OC90", "1,5", "a,b,c"}},
+		},
+		{
+			name: "табуляция",
+			data: "article\tprice\nOC90\t1,5\n",
+			want: [][]string{{"article", "price"}, {"OC90", "1,5"}},
+		},
+		{
+			name: "BOM не попадает в первый столбец",
+			data: "\xEF\xBB\xBFartikel;price\r\nOC90;100\r\n",
+			want: [][]string{{"artikel", "price"}, {"OC90", "100"}},
+		},
+		{
+			name:      "явный разделитель важнее определения",
+			data:      "a;b,c;d\n",
+			delimiter: ',',
+			want:      [][]string{{"a;b", "c;d"}},
+		},
+		{
+			name: "строки разной длины и кавычки",
+			data: "a,b,c\n\"x, y\",1\n",
+			want: [][]string{{"a", "b", "c"}, {"x, y", "1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Open(strings.NewReader(tt.data), models.ImportSpec{Format: models.ImportFormatCSV, Delimiter: tt.delimiter})
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+			if got := readAll(t, r); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("строки %q, ожидалось %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestXLSXReader(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+	f.NewSheet("Цены")
+	f.SetSheetRow("Цены", "A1", &[]any{"article", "price"})
+	f.SetSheetRow("Цены", "A2", &[]any{"OC90", 100})
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	r, err := Open(bytes.NewReader(buf.Bytes()), models.ImportSpec{Format: models.ImportFormatXLSX, Sheet: "Цены"})
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	want := [][]string{{"article", "price"}, {"OC90", "100"}}
+	if got := readAll(t, r); !reflect.DeepEqual(got, want) {
+		t.Errorf("строки %q, ожидалось %q", got, want)
+	}
+
+	if _, err := Open(bytes.NewReader(buf.Bytes()), models.ImportSpec{Format: models.ImportFormatXLSX, Sheet: "Нет"}); err == nil {
+		t.Error("несуществующий лист: ожидалась ошибка")
+	}
+	if _, err := Open(strings.NewReader("не zip"), models.ImportSpec{Format: models.ImportFormatXLSX}); err == nil {
+		t.Error("не XLSX: ожидалась ошибка")
+	}
+	if _, err := Open(strings.NewReader(""), models.ImportSpec{Format: "ods"}); err == nil {
+		t.Error("неизвестный формат: ожидалась ошибка")
+	}
+}
+
+func TestColumnIndex(t *testing.T) {
+	header := []string{"Артикул", " Цена ", "A", "Остаток"}
+	tests := []struct {
+		ref     string
+		header  []string
+		want    int
+		wantErr bool
+	}{
+		{ref: "артикул", header: header, want: 0},
+		{ref: "Цена", header: header, want: 1},
+		{ref: "A", header: header, want: 2},
+		{ref: "4", header: header, want: 3},
+		{ref: "d", header: header, want: 3},
+		{ref: "AA", header: nil, want: 26},
+		{ref: "2", header: nil, want: 1},
+		{ref: "0", header: nil, wantErr: true},
+		{ref: "Цена", header: nil, wantErr: true},
+		{ref: "Бренд", header: header, wantErr: true},
+		{ref: "ABCD", header: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ColumnIndex(tt.ref, tt.header)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ColumnIndex(%q): ошибка %v, ожидалась ошибка: %v", tt.ref, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("ColumnIndex(%q) = %d, ожидалось %d", tt.ref, got, tt.want)
+		}
+	}
+}
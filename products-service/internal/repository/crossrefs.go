@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"product-service/internal/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CrossReferenceRepository - репозиторий таблицы кроссов (аналогов) артикулов
+type CrossReferenceRepository struct {
+	collection *mongo.Collection
+}
+
+// NewCrossReferenceRepository - конструктор репозитория кроссов
+func NewCrossReferenceRepository(db *mongo.Database) *CrossReferenceRepository {
+	return &CrossReferenceRepository{
+		collection: db.Collection("crossrefs"),
+	}
+}
+
+// EnsureIndexes - уникальный индекс по паре артикулов и индекс для поиска в обратную сторону
+func (r *CrossReferenceRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "article_normalized", Value: 1},
+				{Key: "brand", Value: 1},
+				{Key: "analog_article_normalized", Value: 1},
+				{Key: "analog_brand", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "analog_article_normalized", Value: 1}}},
+	})
+	return err
+}
+
+// Add - добавление кроссов, уже существующие пары пропускаются. Возвращает количество новых записей.
+func (r *CrossReferenceRepository) Add(ctx context.Context, refs []models.CrossReference) (int64, error) {
+	if len(refs) == 0 {
+		return 0, nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(refs))
+	for _, ref := range refs {
+		filter := bson.M{
+			"article_normalized":        ref.ArticleNormalized,
+			"brand":                     ref.Brand,
+			"analog_article_normalized": ref.AnalogArticleNormalized,
+			"analog_brand":              ref.AnalogBrand,
+		}
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(bson.M{"$setOnInsert": ref}).
+			SetUpsert(true))
+	}
+
+	result, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return 0, err
+	}
+	return result.UpsertedCount, nil
+}
+
+// ByArticle - кроссы, в которых артикул участвует с любой стороны
+func (r *CrossReferenceRepository) ByArticle(ctx context.Context, articleNormalized string) ([]models.CrossReference, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"article_normalized": articleNormalized},
+		bson.M{"analog_article_normalized": articleNormalized},
+	}}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var refs []models.CrossReference
+	if err := cursor.All(ctx, &refs); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"product-service/internal/models"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// caseInsensitive - сравнение марок, моделей и кодов без учета регистра
+var caseInsensitive = &options.Collation{Locale: "ru", Strength: 2}
+
+// FitmentRepository - репозиторий применимости продуктов к автомобилям
+type FitmentRepository struct {
+	collection *mongo.Collection
+}
+
+// NewFitmentRepository - конструктор репозитория применимости
+func NewFitmentRepository(db *mongo.Database) *FitmentRepository {
+	return &FitmentRepository{
+		collection: db.Collection("fitments"),
+	}
+}
+
+// EnsureIndexes - создание индексов для подбора по автомобилю и по продукту
+func (r *FitmentRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "make", Value: 1}, {Key: "model", Value: 1}, {Key: "year_from", Value: 1}, {Key: "year_to", Value: 1}},
+			Options: options.Index().SetCollation(caseInsensitive),
+		},
+		{Keys: bson.D{{Key: "product_id", Value: 1}}},
+	})
+	return err
+}
+
+// ListByProduct - применимость продукта
+func (r *FitmentRepository) ListByProduct(ctx context.Context, productID string) ([]models.Fitment, error) {
+	findOptions := options.Find().SetSort(bson.D{{Key: "make", Value: 1}, {Key: "model", Value: 1}, {Key: "year_from", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"product_id": productID}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	fitments := []models.Fitment{}
+	if err := cursor.All(ctx, &fitments); err != nil {
+		return nil, err
+	}
+	return fitments, nil
+}
+
+// ReplaceForProduct - замена всей применимости продукта
+func (r *FitmentRepository) ReplaceForProduct(ctx context.Context, productID string, fitments []models.Fitment) error {
+	if err := r.DeleteByProduct(ctx, productID); err != nil {
+		return err
+	}
+	if len(fitments) == 0 {
+		return nil
+	}
+
+	docs := make([]any, 0, len(fitments))
+	for _, f := range fitments {
+		docs = append(docs, f)
+	}
+	_, err := r.collection.InsertMany(ctx, docs)
+	return err
+}
+
+// DeleteByProduct - удаление применимости продукта
+func (r *FitmentRepository) DeleteByProduct(ctx context.Context, productID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"product_id": productID})
+	return err
+}
+
+// Makes - список марок, для которых есть запчасти
+func (r *FitmentRepository) Makes(ctx context.Context) ([]string, error) {
+	return r.distinct(ctx, "make", bson.M{})
+}
+
+// Models - список моделей марки, для которых есть запчасти
+func (r *FitmentRepository) Models(ctx context.Context, vehicleMake string) ([]string, error) {
+	return r.distinct(ctx, "model", bson.M{"make": vehicleMake})
+}
+
+// ProductIDs - идентификаторы продуктов, подходящих автомобилю
+func (r *FitmentRepository) ProductIDs(ctx context.Context, vehicle models.Vehicle) ([]string, error) {
+	return r.distinct(ctx, "product_id", vehicleQuery(vehicle))
+}
+
+func (r *FitmentRepository) distinct(ctx context.Context, field string, filter bson.M) ([]string, error) {
+	values, err := r.collection.Distinct(ctx, field, filter, options.Distinct().SetCollation(caseInsensitive))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok && s != "" {
+			result = append(result, s)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// vehicleQuery - условие выборки применимости для автомобиля.
+// Пустые границы годов, двигатель и кузов в применимости подходят любому автомобилю.
+func vehicleQuery(vehicle models.Vehicle) bson.M {
+	var conditions bson.A
+	if vehicle.Year > 0 {
+		conditions = append(conditions,
+			bson.M{"$or": bson.A{bson.M{"year_from": 0}, bson.M{"year_from": bson.M{"$lte": vehicle.Year}}}},
+			bson.M{"$or": bson.A{bson.M{"year_to": 0}, bson.M{"year_to": bson.M{"$gte": vehicle.Year}}}},
+		)
+	}
+	if vehicle.EngineCode != "" {
+		conditions = append(conditions, bson.M{"engine_code": bson.M{"$in": bson.A{"", vehicle.EngineCode}}})
+	}
+	if vehicle.Body != "" {
+		conditions = append(conditions, bson.M{"body": bson.M{"$in": bson.A{"", vehicle.Body}}})
+	}
+
+	query := bson.M{"make": vehicle.Make}
+	if vehicle.Model != "" {
+		query["model"] = vehicle.Model
+	}
+	if len(conditions) > 0 {
+		query["$and"] = conditions
+	}
+	return query
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"product-service/internal/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ImportJobRepository - репозиторий задач импорта прайс-листов
+type ImportJobRepository struct {
+	collection *mongo.Collection
+}
+
+// NewImportJobRepository - конструктор репозитория задач импорта
+func NewImportJobRepository(db *mongo.Database) *ImportJobRepository {
+	return &ImportJobRepository{
+		collection: db.Collection("import_jobs"),
+	}
+}
+
+// EnsureIndexes - индекс по состоянию для поиска прерванных задач
+func (r *ImportJobRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}}},
+	})
+	return err
+}
+
+// Create - сохранение новой задачи
+func (r *ImportJobRepository) Create(ctx context.Context, job *models.ImportJob) error {
+	_, err := r.collection.InsertOne(ctx, job)
+	return err
+}
+
+// Save - сохранение состояния и отчета задачи
+func (r *ImportJobRepository) Save(ctx context.Context, job *models.ImportJob) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": job.ID}, job)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return models.ErrImportJobNotFound
+	}
+	return nil
+}
+
+// GetByID - получение задачи по ID
+func (r *ImportJobRepository) GetByID(ctx context.Context, id string) (*models.ImportJob, error) {
+	var job models.ImportJob
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrImportJobNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FailInterrupted - перевод незавершенных задач в состояние ошибки.
+// Задачи выполняются в памяти процесса, поэтому после перезапуска сервиса они уже не продолжатся.
+func (r *ImportJobRepository) FailInterrupted(ctx context.Context, reason string) (int64, error) {
+	now := time.Now().UTC()
+	result, err := r.collection.UpdateMany(ctx,
+		bson.M{"status": bson.M{"$in": bson.A{models.ImportPending, models.ImportRunning}}},
+		bson.M{"$set": bson.M{"status": models.ImportFailed, "error": reason, "finished_at": now}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"product-service/internal/models"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// OfferRepository - репозиторий предложений поставщиков
+type OfferRepository struct {
+	collection *mongo.Collection
+}
+
+// NewOfferRepository - конструктор репозитория предложений
+func NewOfferRepository(db *mongo.Database) *OfferRepository {
+	return &OfferRepository{
+		collection: db.Collection("offers"),
+	}
+}
+
+// EnsureIndexes - уникальный индекс по продукту и поставщику
+func (r *OfferRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "product_id", Value: 1}, {Key: "supplier", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	return err
+}
+
+// Upsert - создание или обновление предложений по паре продукт-поставщик.
+// Возвращает количество новых и измененных предложений.
+func (r *OfferRepository) Upsert(ctx context.Context, offers []models.Offer) (inserted, updated int64, err error) {
+	if len(offers) == 0 {
+		return 0, 0, nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(offers))
+	for _, offer := range offers {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"product_id": offer.ProductID, "supplier": offer.Supplier}).
+			SetUpdate(bson.M{
+				"$set": bson.M{
+					"price":         offer.Price,
+					"currency":      offer.Currency,
+					"quantity":      offer.Quantity,
+					"delivery_days": offer.DeliveryDays,
+					"updated_at":    offer.UpdatedAt,
+					"url":           offer.URL,
+					"title":         offer.Title,
+					"condition":     offer.Condition,
+					"image_url":     offer.ImageURL,
+					"seller":        offer.Seller,
+					"location":      offer.Location,
+				},
+				"$setOnInsert": bson.M{"_id": uuid.NewString()},
+			}).
+			SetUpsert(true))
+	}
+
+	result, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return 0, 0, err
+	}
+	return result.UpsertedCount, result.ModifiedCount, nil
+}
+
+// ListByProduct - предложения по продукту в заданном порядке, предложения в наличии первыми
+func (r *OfferRepository) ListByProduct(ctx context.Context, productID, sortBy string) ([]models.Offer, error) {
+	order := bson.D{{Key: "price", Value: 1}, {Key: "delivery_days", Value: 1}}
+	if sortBy == models.OfferSortDelivery {
+		order = bson.D{{Key: "delivery_days", Value: 1}, {Key: "price", Value: 1}}
+	}
+
+	sort := bson.D{{Key: "_out_of_stock", Value: 1}}
+	sort = append(sort, order...)
+	sort = append(sort, bson.E{Key: "_id", Value: 1})
+
+	// Сортировку по наличию выполняем в агрегации: в обычном find нельзя сортировать по вычисляемому полю
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"product_id": productID}}},
+		{{Key: "$addFields", Value: bson.M{"_out_of_stock": bson.M{"$lte": bson.A{
+			bson.M{"$subtract": bson.A{"$quantity", bson.M{"$ifNull": bson.A{"$reserved", 0}}}},
+			0,
+		}}}}},
+		{{Key: "$sort", Value: sort}},
+		{{Key: "$project", Value: bson.M{"_out_of_stock": 0}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	offers := []models.Offer{}
+	if err := cursor.All(ctx, &offers); err != nil {
+		return nil, err
+	}
+	return offers, nil
+}
+
+// DeleteByProduct - удаление всех предложений по продукту
+func (r *OfferRepository) DeleteByProduct(ctx context.Context, productID string) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"product_id": productID})
+	return err
+}
+
+// availableAtLeast - условие, что доступно для заказа не меньше quantity единиц
+func availableAtLeast(quantity int) bson.M {
+	return bson.M{"$expr": bson.M{"$gte": bson.A{
+		bson.M{"$subtract": bson.A{"$quantity", bson.M{"$ifNull": bson.A{"$reserved", 0}}}},
+		quantity,
+	}}}
+}
+
+// GetByID - получение предложения по ID
+func (r *OfferRepository) GetByID(ctx context.Context, id string) (*models.Offer, error) {
+	var offer models.Offer
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&offer)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &offer, nil
+}
+
+// Hold - удержание quantity единиц предложения под резерв заказа orderID.
+// Проверка остатка, увеличение резерва и отметка заказа выполняются одной операцией, поэтому параллельные резервы
+// не уводят остаток в минус, а повторное удержание для того же заказа не меняет резерв и возвращает true.
+// Возвращает false, если доступного количества не хватает.
+func (r *OfferRepository) Hold(ctx context.Context, id, orderID string, quantity int) (bool, error) {
+	filter := availableAtLeast(quantity)
+	filter["_id"] = id
+	filter["held_by"] = bson.M{"$ne": orderID}
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$inc":  bson.M{"reserved": quantity},
+		"$push": bson.M{"held_by": orderID},
+	})
+	if err != nil {
+		return false, err
+	}
+	if result.MatchedCount == 1 {
+		return true, nil
+	}
+	// Количество могло быть удержано прерванной попыткой
+	held, err := r.collection.CountDocuments(ctx, bson.M{"_id": id, "held_by": orderID})
+	return held > 0, err
+}
+
+// Unhold - возврат удержанных под заказ единиц в доступный остаток.
+// Если под заказ ничего не удержано, предложение не меняется.
+func (r *OfferRepository) Unhold(ctx context.Context, id, orderID string, quantity int) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "held_by": orderID}, bson.M{
+		"$inc":  bson.M{"reserved": -quantity},
+		"$pull": bson.M{"held_by": orderID},
+	})
+	return err
+}
+
+// Commit - списание удержанных под заказ единиц: товар продан и уходит из остатка поставщика.
+// Если под заказ ничего не удержано, предложение не меняется.
+func (r *OfferRepository) Commit(ctx context.Context, id, orderID string, quantity int) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "held_by": orderID}, bson.M{
+		"$inc":  bson.M{"quantity": -quantity, "reserved": -quantity},
+		"$pull": bson.M{"held_by": orderID},
+	})
+	return err
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"product-service/internal/models"
+	"strconv"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -21,29 +23,62 @@ func NewProductRepository(db *mongo.Database) *ProductRepository {
 	}
 }
 
+// EnsureIndexes - создание полнотекстового индекса по названию и описанию и индексов для фильтров поиска
+func (r *ProductRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().
+				SetName("products_text").
+				SetWeights(bson.D{{Key: "name", Value: 10}, {Key: "description", Value: 1}}).
+				SetDefaultLanguage("russian"),
+		},
+		{Keys: bson.D{{Key: "category", Value: 1}, {Key: "price", Value: 1}}},
+		{Keys: bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "article_normalized", Value: 1}, {Key: "brand", Value: 1}}},
+	})
+	return err
+}
+
 // Create - создание нового продукта в базе данных
 func (r *ProductRepository) Create(ctx context.Context, product *models.Product) error {
 	_, err := r.collection.InsertOne(ctx, product)
 	return err
 }
 
+// CreateMany - создание нескольких продуктов
+func (r *ProductRepository) CreateMany(ctx context.Context, products []models.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+	docs := make([]any, 0, len(products))
+	for i := range products {
+		docs = append(docs, &products[i])
+	}
+	_, err := r.collection.InsertMany(ctx, docs)
+	return err
+}
+
 // GetByID - получение продукта по ID
 func (r *ProductRepository) GetByID(ctx context.Context, id string) (*models.Product, error) {
 	var product models.Product
 	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&product)
-	if err != nil {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrNotFound
+	} else if err != nil {
 		return nil, err
 	}
 	return &product, nil
 }
 
-// List - получение списка всех продуктов
-// List - получение списка продуктов
-func (r *ProductRepository) List(ctx context.Context) ([]models.Product, error) {
+// List - получение страницы продуктов, новые продукты первыми
+func (r *ProductRepository) List(ctx context.Context, offset, limit int) ([]models.Product, error) {
 	var products []models.Product
 
-	// Используем options.Find() для возможности кастомизации запроса в будущем
-	findOptions := options.Find()
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
 
 	cursor, err := r.collection.Find(ctx, bson.M{}, findOptions)
 	if err != nil {
@@ -67,6 +102,108 @@ func (r *ProductRepository) List(ctx context.Context) ([]models.Product, error)
 	return products, nil
 }
 
+// ListByArticles - продукты с любым из переданных нормализованных артикулов
+func (r *ProductRepository) ListByArticles(ctx context.Context, articles []string) ([]models.Product, error) {
+	findOptions := options.Find().SetSort(bson.D{{Key: "brand", Value: 1}, {Key: "price", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"article_normalized": bson.M{"$in": articles}}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var products []models.Product
+	if err := cursor.All(ctx, &products); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// Count - общее количество продуктов
+func (r *ProductRepository) Count(ctx context.Context) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{})
+}
+
+// Search - поиск страницы продуктов по фильтру, возвращает также общее количество найденных продуктов
+func (r *ProductRepository) Search(ctx context.Context, filter models.ProductFilter, offset, limit int) ([]models.Product, int64, error) {
+	query := searchQuery(filter)
+
+	findOptions := options.Find().
+		SetSort(searchSort(filter)).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	if filter.Query != "" {
+		findOptions.SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}})
+	}
+
+	cursor, err := r.collection.Find(ctx, query, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var products []models.Product
+	if err := cursor.All(ctx, &products); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	return products, total, nil
+}
+
+// searchQuery - условие выборки MongoDB для фильтра поиска
+func searchQuery(filter models.ProductFilter) bson.M {
+	query := bson.M{}
+	if filter.Query != "" {
+		query["$text"] = bson.M{"$search": filter.Query}
+	}
+	if filter.Category != "" {
+		query["category"] = filter.Category
+	}
+	if filter.ProductIDs != nil {
+		query["_id"] = bson.M{"$in": filter.ProductIDs}
+	}
+
+	price := bson.M{}
+	if filter.MinPrice != nil {
+		price["$gte"] = *filter.MinPrice
+	}
+	if filter.MaxPrice != nil {
+		price["$lte"] = *filter.MaxPrice
+	}
+	if len(price) > 0 {
+		query["price"] = price
+	}
+
+	// Значения характеристик приходят строками, а в базе могут храниться числом или логическим значением
+	for key, value := range filter.Attributes {
+		candidates := bson.A{value}
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			candidates = append(candidates, n)
+		}
+		if b, err := strconv.ParseBool(value); err == nil {
+			candidates = append(candidates, b)
+		}
+		query["attributes."+key] = bson.M{"$in": candidates}
+	}
+	return query
+}
+
+// searchSort - порядок сортировки результатов поиска, _id обеспечивает стабильность страниц
+func searchSort(filter models.ProductFilter) bson.D {
+	switch filter.Sort {
+	case models.SortRelevance:
+		return bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "_id", Value: 1}}
+	case models.SortPriceAsc:
+		return bson.D{{Key: "price", Value: 1}, {Key: "_id", Value: 1}}
+	case models.SortPriceDesc:
+		return bson.D{{Key: "price", Value: -1}, {Key: "_id", Value: 1}}
+	}
+	return bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: 1}}
+}
+
 // Update - обновление существующего продукта в базе данных
 func (r *ProductRepository) Update(ctx context.Context, product *models.Product) error {
 	// Мы используем ID продукта как уникальный идентификатор для поиска
@@ -75,20 +212,55 @@ func (r *ProductRepository) Update(ctx context.Context, product *models.Product)
 	// Обновляем только измененные поля
 	update := bson.M{
 		"$set": bson.M{
-			"name":        product.Name,
-			"description": product.Description,
-			"price":       product.Price,
+			"name":               product.Name,
+			"description":        product.Description,
+			"price":              product.Price,
+			"category":           product.Category,
+			"attributes":         product.Attributes,
+			"brand":              product.Brand,
+			"article":            product.Article,
+			"article_normalized": product.ArticleNormalized,
+			"updated_at":         product.UpdatedAt,
 		},
 	}
 
 	// Выполняем операцию обновления
-	_, err := r.collection.UpdateOne(ctx, filter, update)
-	return err
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
+// SetOfferSummary - сохранение лучшего предложения и количества предложений в наличии
+func (r *ProductRepository) SetOfferSummary(ctx context.Context, productID string, best *models.Offer, count int) error {
+	update := bson.M{"$set": bson.M{"best_offer": best, "offer_count": count}}
+	if best == nil {
+		update = bson.M{"$unset": bson.M{"best_offer": "", "offer_count": ""}}
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": productID}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+	return nil
 }
 
 // Delete - удаление продукта по ID
 func (r *ProductRepository) Delete(ctx context.Context, product *models.Product) error {
 	// Удаляем продукт по ID
-	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": product.ID})
-	return err
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": product.ID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+	return nil
 }
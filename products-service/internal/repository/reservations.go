@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"product-service/internal/models"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ReservationRepository - репозиторий резервов остатков
+type ReservationRepository struct {
+	collection *mongo.Collection
+}
+
+// NewReservationRepository - конструктор репозитория резервов
+func NewReservationRepository(db *mongo.Database) *ReservationRepository {
+	return &ReservationRepository{
+		collection: db.Collection("reservations"),
+	}
+}
+
+// EnsureIndexes - индексы для поиска истекших активных резервов и закрытых резервов с удержанными позициями
+func (r *ReservationRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "expires_at", Value: 1}}},
+		{
+			Keys:    bson.D{{Key: "items.held", Value: 1}, {Key: "status", Value: 1}},
+			Options: options.Index().SetPartialFilterExpression(bson.M{"items.held": true}),
+		},
+		{
+			Keys:    bson.D{{Key: "items.holding", Value: 1}, {Key: "status", Value: 1}},
+			Options: options.Index().SetPartialFilterExpression(bson.M{"items.holding": true}),
+		},
+	})
+	return err
+}
+
+// Create - сохранение нового резерва. Если резерв для заказа уже есть, возвращается false.
+func (r *ReservationRepository) Create(ctx context.Context, reservation *models.Reservation) (bool, error) {
+	_, err := r.collection.InsertOne(ctx, reservation)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetByID - получение резерва по ID заказа
+func (r *ReservationRepository) GetByID(ctx context.Context, id string) (*models.Reservation, error) {
+	var reservation models.Reservation
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&reservation)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrReservationNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// MarkHolding - отметка, что начато удержание позиции резерва в предложении
+func (r *ReservationRepository) MarkHolding(ctx context.Context, id string, index int) error {
+	return r.setItem(ctx, id, index, bson.M{"holding": true})
+}
+
+// MarkHeld - отметка, что позиция резерва удержана в предложении
+func (r *ReservationRepository) MarkHeld(ctx context.Context, id string, index int) error {
+	return r.setItem(ctx, id, index, bson.M{"held": true})
+}
+
+// ClearHeld - отметка, что удержание позиции снято: количество возвращено в предложение или списано
+func (r *ReservationRepository) ClearHeld(ctx context.Context, id string, index int) error {
+	return r.setItem(ctx, id, index, bson.M{"holding": false, "held": false})
+}
+
+// setItem - изменение полей позиции резерва
+func (r *ReservationRepository) setItem(ctx context.Context, id string, index int, fields bson.M) error {
+	set := bson.M{}
+	for field, value := range fields {
+		set["items."+strconv.Itoa(index)+"."+field] = value
+	}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": set})
+	return err
+}
+
+// Transition - перевод активного резерва в состояние status.
+// Переход выполняется одной операцией, поэтому закрыть резерв может только один запрос;
+// возврат или списание остатков отмечается по позициям через ClearHeld.
+// Если резерв уже не активен, возвращается false и текущее состояние резерва.
+func (r *ReservationRepository) Transition(ctx context.Context, id, status string, now time.Time) (*models.Reservation, bool, error) {
+	var reservation models.Reservation
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "status": models.ReservationActive},
+		bson.M{"$set": bson.M{"status": status, "updated_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&reservation)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		current, err := r.GetByID(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		return current, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return &reservation, true, nil
+}
+
+// ListExpired - активные резервы с истекшим временем жизни
+func (r *ReservationRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]models.Reservation, error) {
+	findOptions := options.Find().SetSort(bson.D{{Key: "expires_at", Value: 1}}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, bson.M{"status": models.ReservationActive, "expires_at": bson.M{"$lte": now}}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	reservations := []models.Reservation{}
+	if err := cursor.All(ctx, &reservations); err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// ListUnsettled - закрытые резервы, по которым остались удержанные или начатые удерживаться позиции:
+// возврат или списание остатков прервался и должен быть завершен
+func (r *ReservationRepository) ListUnsettled(ctx context.Context, limit int) ([]models.Reservation, error) {
+	filter := bson.M{
+		"$or":    bson.A{bson.M{"items.held": true}, bson.M{"items.holding": true}},
+		"status": bson.M{"$ne": models.ReservationActive},
+	}
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	reservations := []models.Reservation{}
+	if err := cursor.All(ctx, &reservations); err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// Delete - удаление резерва
+func (r *ReservationRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
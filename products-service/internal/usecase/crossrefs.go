@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"product-service/internal/models"
+	"strings"
+)
+
+// FindByArticle - поиск продуктов по артикулу и их аналогов по таблице кроссов.
+// Производитель необязателен; аналоги ищутся только на один шаг, без транзитивного замыкания.
+func (s *ProductService) FindByArticle(ctx context.Context, article, brand string) (*models.ArticleMatch, error) {
+	normalized := models.NormalizeArticle(article)
+	brand = strings.TrimSpace(brand)
+	if normalized == "" {
+		verr := &models.ValidationError{}
+		verr.Add("article", "артикул должен содержать буквы или цифры")
+		return nil, verr
+	}
+
+	refs, err := s.crossrefs.ByArticle(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	// Собираем артикулы с другой стороны кроссов, подходящих по производителю
+	var analogs []articleKey
+	for _, ref := range refs {
+		if ref.ArticleNormalized == normalized && brandMatches(ref.Brand, brand) {
+			analogs = append(analogs, articleKey{article: ref.AnalogArticleNormalized, brand: ref.AnalogBrand})
+		}
+		if ref.AnalogArticleNormalized == normalized && brandMatches(ref.AnalogBrand, brand) {
+			analogs = append(analogs, articleKey{article: ref.ArticleNormalized, brand: ref.Brand})
+		}
+	}
+
+	articles := []string{normalized}
+	for _, key := range analogs {
+		articles = append(articles, key.article)
+	}
+	products, err := s.repo.ListByArticles(ctx, articles)
+	if err != nil {
+		return nil, err
+	}
+
+	match := &models.ArticleMatch{ArticleNormalized: normalized}
+	for _, product := range products {
+		switch {
+		case product.ArticleNormalized == normalized && brandMatches(product.Brand, brand):
+			match.Exact = append(match.Exact, product)
+		case matchesAny(product, analogs):
+			match.Analogs = append(match.Analogs, product)
+		}
+	}
+
+	if len(match.Exact) == 0 && len(match.Analogs) == 0 {
+		return nil, fmt.Errorf("артикул %s: %w", normalized, models.ErrNotFound)
+	}
+	return match, nil
+}
+
+// AddCrossReferences - добавление кроссов, возвращает количество новых записей
+func (s *ProductService) AddCrossReferences(ctx context.Context, refs []models.CrossReference) (int64, error) {
+	verr := &models.ValidationError{}
+	for i := range refs {
+		ref := &refs[i]
+		ref.Brand = strings.TrimSpace(ref.Brand)
+		ref.AnalogBrand = strings.TrimSpace(ref.AnalogBrand)
+		ref.Article = strings.TrimSpace(ref.Article)
+		ref.AnalogArticle = strings.TrimSpace(ref.AnalogArticle)
+		ref.ArticleNormalized = models.NormalizeArticle(ref.Article)
+		ref.AnalogArticleNormalized = models.NormalizeArticle(ref.AnalogArticle)
+
+		prefix := fmt.Sprintf("cross_references[%d].", i)
+		if ref.ArticleNormalized == "" {
+			verr.Add(prefix+"article", "артикул должен содержать буквы или цифры")
+		}
+		if ref.AnalogArticleNormalized == "" {
+			verr.Add(prefix+"analog_article", "артикул должен содержать буквы или цифры")
+		}
+		if ref.ArticleNormalized == ref.AnalogArticleNormalized && strings.EqualFold(ref.Brand, ref.AnalogBrand) {
+			verr.Add(prefix+"analog_article", "артикул не может быть аналогом самого себя")
+		}
+	}
+	if err := verr.Err(); err != nil {
+		return 0, err
+	}
+	return s.crossrefs.Add(ctx, refs)
+}
+
+// articleKey - артикул с производителем, пустой производитель означает любого
+type articleKey struct {
+	article string
+	brand   string
+}
+
+// brandMatches - совпадение производителя без учета регистра; пустое значение совпадает с любым
+func brandMatches(actual, wanted string) bool {
+	return wanted == "" || actual == "" || strings.EqualFold(actual, wanted)
+}
+
+// matchesAny - продукт подходит под один из артикулов-аналогов
+func matchesAny(product models.Product, keys []articleKey) bool {
+	for _, key := range keys {
+		if product.ArticleNormalized == key.article && brandMatches(product.Brand, key.brand) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"product-service/internal/models"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// maxYear - верхняя граница года выпуска при проверке применимости
+const maxYear = 2100
+
+// Makes - список марок, для которых есть запчасти
+func (s *ProductService) Makes(ctx context.Context) ([]string, error) {
+	return s.fitments.Makes(ctx)
+}
+
+// Models - список моделей марки, для которых есть запчасти
+func (s *ProductService) Models(ctx context.Context, vehicleMake string) ([]string, error) {
+	vehicleMake = strings.TrimSpace(vehicleMake)
+	if vehicleMake == "" {
+		verr := &models.ValidationError{}
+		verr.Add("make", "марка не может быть пустой")
+		return nil, verr
+	}
+	return s.fitments.Models(ctx, vehicleMake)
+}
+
+// Fitments - применимость продукта
+func (s *ProductService) Fitments(ctx context.Context, productID string) ([]models.Fitment, error) {
+	if _, err := s.repo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+	return s.fitments.ListByProduct(ctx, productID)
+}
+
+// SetFitments - замена применимости продукта, возвращает сохраненную применимость
+func (s *ProductService) SetFitments(ctx context.Context, productID string, fitments []models.Fitment) ([]models.Fitment, error) {
+	verr := &models.ValidationError{}
+	for i := range fitments {
+		f := &fitments[i]
+		f.ID = uuid.NewString()
+		f.ProductID = productID
+		f.Make = strings.TrimSpace(f.Make)
+		f.Model = strings.TrimSpace(f.Model)
+		f.EngineCode = strings.TrimSpace(f.EngineCode)
+		f.Body = strings.TrimSpace(f.Body)
+		validateFitment(verr, fmt.Sprintf("fitments[%d].", i), f)
+	}
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+	if err := s.fitments.ReplaceForProduct(ctx, productID, fitments); err != nil {
+		return nil, err
+	}
+	return s.fitments.ListByProduct(ctx, productID)
+}
+
+// validateFitment - проверка записи применимости
+func validateFitment(verr *models.ValidationError, prefix string, f *models.Fitment) {
+	if f.Make == "" {
+		verr.Add(prefix+"make", "марка не может быть пустой")
+	}
+	if f.Model == "" {
+		verr.Add(prefix+"model", "модель не может быть пустой")
+	}
+	if f.YearFrom < 0 || f.YearFrom > maxYear {
+		verr.Add(prefix+"year_from", "некорректный год")
+	}
+	if f.YearTo < 0 || f.YearTo > maxYear {
+		verr.Add(prefix+"year_to", "некорректный год")
+	}
+	if f.YearFrom > 0 && f.YearTo > 0 && f.YearFrom > f.YearTo {
+		verr.Add(prefix+"year_to", "год окончания выпуска раньше года начала")
+	}
+}
+
+// validateVehicle - проверка параметров автомобиля для подбора
+func validateVehicle(verr *models.ValidationError, prefix string, v models.Vehicle) {
+	if strings.TrimSpace(v.Make) == "" {
+		verr.Add(prefix+"make", "марка не может быть пустой")
+	}
+	if v.Year < 0 || v.Year > maxYear {
+		verr.Add(prefix+"year", "некорректный год")
+	}
+}
@@ -0,0 +1,391 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"product-service/internal/models"
+	"product-service/internal/pricelist"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// importBatchSize - сколько строк прайс-листа записывается за раз
+const importBatchSize = 500
+
+// ValidateImportSpec - проверка параметров импорта и заполнение значений по умолчанию.
+// Вызывается до приема файла, чтобы не загружать его при заведомо неверных параметрах.
+func ValidateImportSpec(spec *models.ImportSpec) error {
+	spec.Supplier = strings.TrimSpace(spec.Supplier)
+	spec.Currency = strings.ToUpper(strings.TrimSpace(spec.Currency))
+	if spec.Currency == "" {
+		spec.Currency = models.DefaultCurrency
+	}
+
+	verr := &models.ValidationError{}
+	if spec.Supplier == "" {
+		verr.Add("supplier", "не указан поставщик")
+	}
+	switch spec.Format {
+	case models.ImportFormatCSV, models.ImportFormatXLSX:
+	default:
+		verr.Add("format", "ожидался формат csv или xlsx")
+	}
+	if strings.TrimSpace(spec.Mapping.Article) == "" {
+		verr.Add("mapping.article", "не указан столбец артикула")
+	}
+	if strings.TrimSpace(spec.Mapping.Price) == "" {
+		verr.Add("mapping.price", "не указан столбец цены")
+	}
+	if spec.HeaderRow < 0 {
+		verr.Add("header_row", "номер строки не может быть отрицательным")
+	}
+	if len(spec.Currency) != 3 {
+		verr.Add("currency", "ожидался трехбуквенный код валюты ISO 4217")
+	}
+	return verr.Err()
+}
+
+// CreateImportJob - создание задачи импорта в состоянии pending
+func (s *ProductService) CreateImportJob(ctx context.Context, spec models.ImportSpec) (*models.ImportJob, error) {
+	if err := ValidateImportSpec(&spec); err != nil {
+		return nil, err
+	}
+	job := &models.ImportJob{
+		ID:        uuid.NewString(),
+		Spec:      spec,
+		Status:    models.ImportPending,
+		Errors:    []models.ImportRowError{},
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.imports.Create(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// ImportJob - получение задачи импорта с отчетом по строкам
+func (s *ProductService) ImportJob(ctx context.Context, id string) (*models.ImportJob, error) {
+	return s.imports.GetByID(ctx, id)
+}
+
+// RunImport - обработка прайс-листа задачи. Прогресс сохраняется после каждой пачки строк.
+// Строки с ошибками попадают в отчет и не мешают загрузке остальных; задача завершается ошибкой,
+// только если файл не читается или недоступна база.
+func (s *ProductService) RunImport(ctx context.Context, job *models.ImportJob, src io.Reader) error {
+	job.Status = models.ImportRunning
+	if err := s.imports.Save(ctx, job); err != nil {
+		return err
+	}
+
+	runErr := s.importRows(ctx, job, src)
+
+	finishedAt := time.Now().UTC()
+	job.FinishedAt = &finishedAt
+	job.Status = models.ImportDone
+	if runErr != nil {
+		job.Status = models.ImportFailed
+		job.Error = runErr.Error()
+	}
+	return errors.Join(runErr, s.imports.Save(ctx, job))
+}
+
+// importRow - разобранная строка прайс-листа
+type importRow struct {
+	article      string
+	normalized   string
+	brand        string
+	name         string
+	description  string
+	category     string
+	price        float32
+	quantity     int
+	deliveryDays int
+}
+
+// importColumns - номера столбцов (с 0), -1 - столбца нет
+type importColumns struct {
+	article, brand, name, price, quantity, deliveryDays, description, category int
+}
+
+func (s *ProductService) importRows(ctx context.Context, job *models.ImportJob, src io.Reader) error {
+	reader, err := pricelist.Open(src, job.Spec)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	var (
+		cols     *importColumns
+		batch    []importRow
+		rowIndex int
+	)
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("строка %d: %w", rowIndex+1, err)
+		}
+		rowIndex++
+
+		if rowIndex < job.Spec.HeaderRow {
+			continue
+		}
+		if rowIndex == job.Spec.HeaderRow {
+			if cols, err = resolveColumns(job.Spec.Mapping, record); err != nil {
+				return err
+			}
+			continue
+		}
+		if cols == nil {
+			if cols, err = resolveColumns(job.Spec.Mapping, nil); err != nil {
+				return err
+			}
+		}
+		if blankRecord(record) {
+			continue
+		}
+
+		job.Rows++
+		row, rowErr := parseImportRow(record, *cols)
+		if rowErr != nil {
+			rowErr.Row = rowIndex
+			job.Failed++
+			job.AddRowError(*rowErr)
+			continue
+		}
+		batch = append(batch, row)
+
+		if len(batch) == importBatchSize {
+			if err := s.importBatch(ctx, job, batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if cols == nil && job.Spec.HeaderRow > 0 {
+		return fmt.Errorf("в файле нет строки заголовка %d", job.Spec.HeaderRow)
+	}
+	return s.importBatch(ctx, job, batch)
+}
+
+// importBatch - запись пачки строк: недостающие продукты создаются, предложения поставщика обновляются.
+// Продукт ищется по нормализованному артикулу и производителю без учета регистра;
+// повтор строки в пачке заменяет предыдущую.
+func (s *ProductService) importBatch(ctx context.Context, job *models.ImportJob, rows []importRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	latest := make(map[string]importRow, len(rows))
+	var order []string
+	articles := make([]string, 0, len(rows))
+	for _, row := range rows {
+		key := importKey(row.normalized, row.brand)
+		if _, ok := latest[key]; !ok {
+			order = append(order, key)
+			articles = append(articles, row.normalized)
+		}
+		latest[key] = row
+	}
+
+	existing, err := s.repo.ListByArticles(ctx, articles)
+	if err != nil {
+		return err
+	}
+	productIDs := make(map[string]string, len(existing))
+	for _, p := range existing {
+		key := importKey(p.ArticleNormalized, p.Brand)
+		if _, ok := productIDs[key]; !ok {
+			productIDs[key] = p.ID
+		}
+	}
+
+	now := time.Now().UTC()
+	var created []models.Product
+	offers := make([]models.Offer, 0, len(order))
+	for _, key := range order {
+		row := latest[key]
+		productID, ok := productIDs[key]
+		if !ok {
+			productID = uuid.NewString()
+			created = append(created, models.Product{
+				ID:                productID,
+				Name:              row.name,
+				Description:       row.description,
+				Price:             row.price,
+				Category:          row.category,
+				Brand:             row.brand,
+				Article:           row.article,
+				ArticleNormalized: row.normalized,
+				CreatedAt:         now,
+				UpdatedAt:         now,
+			})
+		}
+		offers = append(offers, models.Offer{
+			ProductID:    productID,
+			Supplier:     job.Spec.Supplier,
+			Price:        row.price,
+			Currency:     job.Spec.Currency,
+			Quantity:     row.quantity,
+			DeliveryDays: row.deliveryDays,
+			UpdatedAt:    now,
+		})
+	}
+
+	if err := s.repo.CreateMany(ctx, created); err != nil {
+		return err
+	}
+	inserted, updated, err := s.offers.Upsert(ctx, offers)
+	if err != nil {
+		return err
+	}
+	for _, offer := range offers {
+		if err := s.refreshOfferSummary(ctx, offer.ProductID); err != nil {
+			return err
+		}
+	}
+
+	// Повторы строки в пачке объединены в одно предложение, поэтому загруженным считается каждое записанное предложение
+	job.Imported += inserted + updated
+	job.ProductsCreated += int64(len(created))
+	job.OffersInserted += inserted
+	job.OffersUpdated += updated
+	return s.imports.Save(ctx, job)
+}
+
+func importKey(normalized, brand string) string {
+	return normalized + "\x00" + strings.ToLower(strings.TrimSpace(brand))
+}
+
+// resolveColumns - номера столбцов сопоставления; header nil - файл без заголовка
+func resolveColumns(mapping models.ColumnMapping, header []string) (*importColumns, error) {
+	var errs []error
+	resolve := func(field, ref string) int {
+		if strings.TrimSpace(ref) == "" {
+			return -1
+		}
+		i, err := pricelist.ColumnIndex(ref, header)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("mapping.%s: %w", field, err))
+			return -1
+		}
+		return i
+	}
+
+	cols := &importColumns{
+		article:      resolve("article", mapping.Article),
+		brand:        resolve("brand", mapping.Brand),
+		name:         resolve("name", mapping.Name),
+		price:        resolve("price", mapping.Price),
+		quantity:     resolve("quantity", mapping.Quantity),
+		deliveryDays: resolve("delivery_days", mapping.DeliveryDays),
+		description:  resolve("description", mapping.Description),
+		category:     resolve("category", mapping.Category),
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return cols, nil
+}
+
+// parseImportRow - разбор и проверка строки. Без столбца названия продукт называется по производителю и артикулу.
+func parseImportRow(record []string, cols importColumns) (importRow, *models.ImportRowError) {
+	row := importRow{
+		article:     cell(record, cols.article),
+		brand:       cell(record, cols.brand),
+		name:        cell(record, cols.name),
+		description: cell(record, cols.description),
+		category:    cell(record, cols.category),
+	}
+
+	row.normalized = models.NormalizeArticle(row.article)
+	if row.normalized == "" {
+		return row, &models.ImportRowError{Column: "article", Message: "артикул должен содержать буквы или цифры"}
+	}
+	if row.name == "" {
+		row.name = strings.TrimSpace(row.brand + " " + row.article)
+	}
+
+	price, err := parseImportPrice(cell(record, cols.price))
+	if err != nil {
+		return row, &models.ImportRowError{Column: "price", Message: err.Error()}
+	}
+	row.price = price
+
+	if row.quantity, err = parseImportInt(cell(record, cols.quantity)); err != nil {
+		return row, &models.ImportRowError{Column: "quantity", Message: err.Error()}
+	}
+	if row.deliveryDays, err = parseImportInt(cell(record, cols.deliveryDays)); err != nil {
+		return row, &models.ImportRowError{Column: "delivery_days", Message: err.Error()}
+	}
+	return row, nil
+}
+
+// cell - значение ячейки без пробелов по краям, пустое для отсутствующего столбца
+func cell(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+func blankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseImportPrice - цена в формате прайс-листов: "1 234,50", "1234.5", "1,234.50", "1.234,50".
+// Если в значении есть и точка, и запятая, десятичный разделитель - последний из них, другой разделяет разряды;
+// одиночная запятая - десятичный разделитель.
+func parseImportPrice(s string) (float32, error) {
+	if s == "" {
+		return 0, errors.New("не указана цена")
+	}
+	s = strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "").Replace(s)
+	switch dot, comma := strings.LastIndexByte(s, '.'), strings.LastIndexByte(s, ','); {
+	case dot >= 0 && comma > dot:
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.ReplaceAll(s, ",", ".")
+	case dot >= 0:
+		s = strings.ReplaceAll(s, ",", "")
+	default:
+		s = strings.ReplaceAll(s, ",", ".")
+	}
+	price, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return 0, fmt.Errorf("некорректная цена %q", s)
+	}
+	if math.IsNaN(price) || math.IsInf(price, 0) {
+		return 0, fmt.Errorf("некорректная цена %q", s)
+	}
+	if price < 0 {
+		return 0, errors.New("цена не может быть отрицательной")
+	}
+	return float32(price), nil
+}
+
+// parseImportInt - целое неотрицательное число; пустое значение - 0.
+// Поставщики часто пишут остаток как ">10" или "10+", такие значения читаются как 10.
+func parseImportInt(s string) (int, error) {
+	s = strings.Trim(s, "<>=+ ")
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("ожидалось неотрицательное целое число, получено %q", s)
+	}
+	return n, nil
+}
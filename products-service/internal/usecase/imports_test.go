@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"testing"
+
+	"product-service/internal/models"
+)
+
+func TestParseImportPrice(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    float32
+		wantErr bool
+	}{
+		{in: "1234.5", want: 1234.5},
+		{in: "1234,5", want: 1234.5},
+		{in: "1 234,50", want: 1234.5},
+		{in: "1\u00a0234,50", want: 1234.5},
+		{in: "1\u202f234,50", want: 1234.5},
+		{in: "1 234.50", want: 1234.5},
+		{in: "1,234.50", want: 1234.5},
+		{in: "1,234,567.25", want: 1234567.25},
+		{in: "1.234,50", want: 1234.5},
+		{in: "0", want: 0},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1.234.50", wantErr: true},
+		{in: "-5", wantErr: true},
+		{in: "NaN", wantErr: true},
+		{in: "nan", wantErr: true},
+		{in: "Inf", wantErr: true},
+		{in: "+Inf", wantErr: true},
+		{in: "-Infinity", wantErr: true},
+		{in: "1e40", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseImportPrice(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseImportPrice(%q): ошибка %v, ожидалась ошибка: %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("parseImportPrice(%q) = %v, ожидалось %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseImportInt(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{in: "", want: 0},
+		{in: "7", want: 7},
+		{in: ">10", want: 10},
+		{in: "10+", want: 10},
+		{in: "<5", want: 5},
+		{in: ">= 3", want: 3},
+		{in: "-1", wantErr: true},
+		{in: "много", wantErr: true},
+		{in: "2.5", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseImportInt(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseImportInt(%q): ошибка %v, ожидалась ошибка: %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("parseImportInt(%q) = %d, ожидалось %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestResolveColumns(t *testing.T) {
+	header := []string{"Артикул", " Бренд ", "Цена", "Остаток"}
+	cols, err := resolveColumns(models.ColumnMapping{Article: "артикул", Brand: "Бренд", Price: "C", Quantity: "4"}, header)
+	if err != nil {
+		t.Fatalf("resolveColumns: %v", err)
+	}
+	want := importColumns{article: 0, brand: 1, name: -1, price: 2, quantity: 3, deliveryDays: -1, description: -1, category: -1}
+	if *cols != want {
+		t.Errorf("resolveColumns = %+v, ожидалось %+v", *cols, want)
+	}
+
+	if _, err := resolveColumns(models.ColumnMapping{Article: "Код", Price: "Цена"}, header); err == nil {
+		t.Error("столбец, отсутствующий в заголовке: ожидалась ошибка")
+	}
+	if _, err := resolveColumns(models.ColumnMapping{Article: "Артикул", Price: "B"}, nil); err == nil {
+		t.Error("название столбца без заголовка: ожидалась ошибка")
+	}
+}
+
+func TestParseImportRow(t *testing.T) {
+	cols := importColumns{article: 0, brand: 1, name: -1, price: 2, quantity: 3, deliveryDays: 4, description: -1, category: -1}
+	tests := []struct {
+		name   string
+		record []string
+		want   importRow
+		column string
+	}{
+		{
+			name:   "название по производителю и артикулу",
+			record: []string{" oc-90 ", "Knecht", "1 234,50", ">10", "3"},
+			want: importRow{article: "oc-90", normalized: models.NormalizeArticle("oc-90"), brand: "Knecht",
+				name: "Knecht oc-90", price: 1234.5, quantity: 10, deliveryDays: 3},
+		},
+		{
+			name:   "отсутствующие столбцы в конце строки",
+			record: []string{"W712", "Mann", "500"},
+			want:   importRow{article: "W712", normalized: models.NormalizeArticle("W712"), brand: "Mann", name: "Mann W712", price: 500},
+		},
+		{name: "пустой артикул", record: []string{"--", "Mann", "500"}, column: "article"},
+		{name: "без цены", record: []string{"W712", "Mann", ""}, column: "price"},
+		{name: "некорректный остаток", record: []string{"W712", "Mann", "500", "есть"}, column: "quantity"},
+		{name: "некорректный срок", record: []string{"W712", "Mann", "500", "1", "-2"}, column: "delivery_days"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, rowErr := parseImportRow(tt.record, cols)
+			if tt.column != "" {
+				if rowErr == nil || rowErr.Column != tt.column {
+					t.Fatalf("ошибка %+v, ожидалась ошибка в столбце %s", rowErr, tt.column)
+				}
+				return
+			}
+			if rowErr != nil {
+				t.Fatalf("ошибка %+v", rowErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseImportRow = %+v, ожидалось %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"product-service/internal/models"
+	"strings"
+	"time"
+)
+
+// UpsertOffers - загрузка предложений поставщиков с пересчетом лучшего предложения у затронутых продуктов.
+// Возвращает количество новых и измененных предложений.
+func (s *ProductService) UpsertOffers(ctx context.Context, offers []models.Offer) (inserted, updated int64, err error) {
+	now := time.Now().UTC()
+	verr := &models.ValidationError{}
+	for i := range offers {
+		offer := &offers[i]
+		offer.ProductID = strings.TrimSpace(offer.ProductID)
+		offer.Supplier = strings.TrimSpace(offer.Supplier)
+		offer.Currency = strings.ToUpper(strings.TrimSpace(offer.Currency))
+		if offer.Currency == "" {
+			offer.Currency = models.DefaultCurrency
+		}
+		offer.UpdatedAt = now
+		validateOffer(verr, fmt.Sprintf("offers[%d].", i), offer)
+	}
+	if err := verr.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	// Продукты проверяем до записи, чтобы не оставлять предложения без продукта
+	productIDs := make([]string, 0, len(offers))
+	seen := make(map[string]bool, len(offers))
+	for _, offer := range offers {
+		if seen[offer.ProductID] {
+			continue
+		}
+		seen[offer.ProductID] = true
+		if _, err := s.repo.GetByID(ctx, offer.ProductID); err != nil {
+			return 0, 0, fmt.Errorf("продукт %s: %w", offer.ProductID, err)
+		}
+		productIDs = append(productIDs, offer.ProductID)
+	}
+
+	if inserted, updated, err = s.offers.Upsert(ctx, offers); err != nil {
+		return 0, 0, err
+	}
+	for _, productID := range productIDs {
+		if err := s.refreshOfferSummary(ctx, productID); err != nil {
+			return 0, 0, err
+		}
+	}
+	return inserted, updated, nil
+}
+
+// ProductWithOffers - продукт со всеми предложениями, отсортированными по цене или сроку доставки
+func (s *ProductService) ProductWithOffers(ctx context.Context, productID, sortBy string) (*models.ProductWithOffers, error) {
+	switch sortBy {
+	case "":
+		sortBy = models.OfferSortPrice
+	case models.OfferSortPrice, models.OfferSortDelivery:
+	default:
+		verr := &models.ValidationError{}
+		verr.Add("sort", fmt.Sprintf("неизвестный порядок сортировки %q", sortBy))
+		return nil, verr
+	}
+
+	product, err := s.repo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	offers, err := s.offers.ListByProduct(ctx, productID, sortBy)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ProductWithOffers{Product: product, Offers: offers}, nil
+}
+
+// refreshOfferSummary - пересчет лучшего предложения и количества предложений в наличии.
+// Цены в разных валютах не пересчитываются, лучшим считается самое дешевое предложение в наличии.
+func (s *ProductService) refreshOfferSummary(ctx context.Context, productID string) error {
+	offers, err := s.offers.ListByProduct(ctx, productID, models.OfferSortPrice)
+	if err != nil {
+		return err
+	}
+
+	var best *models.Offer
+	count := 0
+	for i := range offers {
+		if !offers[i].InStock() {
+			continue
+		}
+		if best == nil {
+			best = &offers[i]
+		}
+		count++
+	}
+	return s.repo.SetOfferSummary(ctx, productID, best, count)
+}
+
+// validateOffer - проверка предложения поставщика
+func validateOffer(verr *models.ValidationError, prefix string, offer *models.Offer) {
+	if offer.ProductID == "" {
+		verr.Add(prefix+"product_id", "не указан продукт")
+	}
+	if offer.Supplier == "" {
+		verr.Add(prefix+"supplier", "не указан поставщик")
+	}
+	if offer.Price < 0 {
+		verr.Add(prefix+"price", "цена не может быть отрицательной")
+	}
+	if len(offer.Currency) != 3 {
+		verr.Add(prefix+"currency", "ожидался трехбуквенный код валюты ISO 4217")
+	}
+	if offer.Quantity < 0 {
+		verr.Add(prefix+"quantity", "количество не может быть отрицательным")
+	}
+	if offer.DeliveryDays < 0 {
+		verr.Add(prefix+"delivery_days", "срок доставки не может быть отрицательным")
+	}
+	switch offer.Condition {
+	case "", models.ConditionNew, models.ConditionUsed, models.ConditionContract:
+	default:
+		verr.Add(prefix+"condition", fmt.Sprintf("неизвестное состояние %q", offer.Condition))
+	}
+}
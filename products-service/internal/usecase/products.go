@@ -2,27 +2,61 @@ package usecase
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"product-service/internal/models"
 	"product-service/internal/repository"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 // ProductService - сервис для работы с продуктами
 type ProductService struct {
-	repo *repository.ProductRepository
+	repo      *repository.ProductRepository
+	fitments  *repository.FitmentRepository
+	crossrefs *repository.CrossReferenceRepository
+	offers    *repository.OfferRepository
+	imports   *repository.ImportJobRepository
+	// reservations - резервы остатков, reservationTTL - время жизни резерва по умолчанию
+	reservations   *repository.ReservationRepository
+	reservationTTL time.Duration
 }
 
 // NewProductService - конструктор для создания сервиса
-func NewProductService(repo *repository.ProductRepository) *ProductService {
-	return &ProductService{repo: repo}
+func NewProductService(
+	repo *repository.ProductRepository,
+	fitments *repository.FitmentRepository,
+	crossrefs *repository.CrossReferenceRepository,
+	offers *repository.OfferRepository,
+	imports *repository.ImportJobRepository,
+	reservations *repository.ReservationRepository,
+	reservationTTL time.Duration,
+) *ProductService {
+	return &ProductService{
+		repo:           repo,
+		fitments:       fitments,
+		crossrefs:      crossrefs,
+		offers:         offers,
+		imports:        imports,
+		reservations:   reservations,
+		reservationTTL: reservationTTL,
+	}
 }
 
 // Create - создание нового продукта
 func (s *ProductService) Create(ctx context.Context, product *models.Product) error {
+	if err := validateProduct(product); err != nil {
+		return err
+	}
+
 	// Генерация уникального ID для продукта
 	product.ID = uuid.NewString()
+	product.Brand = strings.TrimSpace(product.Brand)
+	product.Article = strings.TrimSpace(product.Article)
+	product.ArticleNormalized = models.NormalizeArticle(product.Article)
+	product.CreatedAt = time.Now().UTC()
+	product.UpdatedAt = product.CreatedAt
 	return s.repo.Create(ctx, product)
 }
 
@@ -31,26 +65,133 @@ func (s *ProductService) GetByID(ctx context.Context, id string) (*models.Produc
 	return s.repo.GetByID(ctx, id)
 }
 
-// List - получение списка всех продуктов
-func (s *ProductService) List(ctx context.Context) ([]models.Product, error) {
-	return s.repo.List(ctx)
+// Ограничения размера страницы списка продуктов
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+// List - получение страницы продуктов и общего количества продуктов
+func (s *ProductService) List(ctx context.Context, offset, limit int) ([]models.Product, int64, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	products, err := s.repo.List(ctx, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.repo.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return products, total, nil
+}
+
+// Search - поиск страницы продуктов по фильтру и общего количества найденных продуктов
+func (s *ProductService) Search(ctx context.Context, filter models.ProductFilter, offset, limit int) ([]models.Product, int64, error) {
+	if err := validateFilter(&filter); err != nil {
+		return nil, 0, err
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	// Подбор по автомобилю сводится к ограничению выборки подходящими продуктами
+	if filter.Vehicle != nil {
+		ids, err := s.fitments.ProductIDs(ctx, *filter.Vehicle)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(ids) == 0 {
+			return nil, 0, nil
+		}
+		filter.ProductIDs = ids
+	}
+
+	return s.repo.Search(ctx, filter, offset, limit)
+}
+
+// validateFilter - проверка фильтра поиска и выбор сортировки по умолчанию
+func validateFilter(filter *models.ProductFilter) error {
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	verr := &models.ValidationError{}
+	if filter.MinPrice != nil && *filter.MinPrice < 0 {
+		verr.Add("min_price", "цена не может быть отрицательной")
+	}
+	if filter.MaxPrice != nil && *filter.MaxPrice < 0 {
+		verr.Add("max_price", "цена не может быть отрицательной")
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		verr.Add("max_price", "максимальная цена меньше минимальной")
+	}
+	if filter.Vehicle != nil {
+		validateVehicle(verr, "vehicle.", *filter.Vehicle)
+	}
+	for key := range filter.Attributes {
+		if key == "" || strings.ContainsAny(key, ".$") {
+			verr.Add("attributes", fmt.Sprintf("некорректное название характеристики %q", key))
+		}
+	}
+
+	switch filter.Sort {
+	case "":
+		filter.Sort = models.SortNewest
+		if filter.Query != "" {
+			filter.Sort = models.SortRelevance
+		}
+	case models.SortRelevance:
+		if filter.Query == "" {
+			verr.Add("sort", "сортировка по релевантности доступна только с текстовым запросом")
+		}
+	case models.SortPriceAsc, models.SortPriceDesc, models.SortNewest:
+	default:
+		verr.Add("sort", fmt.Sprintf("неизвестный порядок сортировки %q", filter.Sort))
+	}
+	return verr.Err()
 }
 
-// Update - обновление данных продукта
-func (s *ProductService) Update(ctx context.Context, product *models.Product) error {
+// Update - обновление данных продукта, возвращает сохраненный продукт
+func (s *ProductService) Update(ctx context.Context, product *models.Product) (*models.Product, error) {
+	if err := validateProduct(product); err != nil {
+		return nil, err
+	}
+
 	// Проверка, существует ли продукт с таким ID
 	existingProduct, err := s.repo.GetByID(ctx, product.ID)
 	if err != nil {
-		return errors.New("продукт не найден")
+		return nil, err
 	}
 
 	// Обновление данных продукта
 	existingProduct.Name = product.Name
 	existingProduct.Description = product.Description
 	existingProduct.Price = product.Price
+	existingProduct.Category = product.Category
+	existingProduct.Attributes = product.Attributes
+	existingProduct.Brand = strings.TrimSpace(product.Brand)
+	existingProduct.Article = strings.TrimSpace(product.Article)
+	existingProduct.ArticleNormalized = models.NormalizeArticle(product.Article)
+	existingProduct.UpdatedAt = time.Now().UTC()
 
 	// Сохранение обновленного продукта в базе
-	return s.repo.Update(ctx, existingProduct)
+	if err := s.repo.Update(ctx, existingProduct); err != nil {
+		return nil, err
+	}
+	return existingProduct, nil
 }
 
 // Delete - удаление продукта по ID
@@ -58,9 +199,30 @@ func (s *ProductService) Delete(ctx context.Context, id string) error {
 	// Проверка, существует ли продукт с таким ID
 	product, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		return errors.New("продукт не найден")
+		return err
+	}
+
+	// Удаление продукта из базы вместе с его применимостью и предложениями
+	if err := s.repo.Delete(ctx, product); err != nil {
+		return err
 	}
+	if err := s.fitments.DeleteByProduct(ctx, product.ID); err != nil {
+		return err
+	}
+	return s.offers.DeleteByProduct(ctx, product.ID)
+}
 
-	// Удаление продукта из базы
-	return s.repo.Delete(ctx, product)
+// validateProduct - проверка полей продукта
+func validateProduct(product *models.Product) error {
+	verr := &models.ValidationError{}
+	if strings.TrimSpace(product.Name) == "" {
+		verr.Add("name", "название не может быть пустым")
+	}
+	if product.Price < 0 {
+		verr.Add("price", "цена не может быть отрицательной")
+	}
+	if strings.TrimSpace(product.Article) != "" && models.NormalizeArticle(product.Article) == "" {
+		verr.Add("article", "артикул должен содержать буквы или цифры")
+	}
+	return verr.Err()
 }
@@ -0,0 +1,310 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product-service/internal/models"
+	"time"
+)
+
+// Ограничения резерва остатков
+const (
+	maxReservationTTL   = 24 * time.Hour
+	maxReservationItems = 100
+	// sweepBatchSize - число истекших резервов, снимаемых за один проход
+	sweepBatchSize = 100
+)
+
+// ReserveStock - резерв остатков предложений под заказ на время ttl (0 - время по умолчанию).
+// Повторный запрос для того же заказа возвращает существующий резерв, если все его позиции удержаны;
+// пока резерв создается, возвращается models.ErrReservationInProgress, для закрытого - *models.ReservationStateError.
+// Если остатка хватает не по всем позициям, ничего не резервируется и возвращается *models.InsufficientStockError.
+func (s *ProductService) ReserveStock(ctx context.Context, orderID string, items []models.ReservationItem, ttl time.Duration) (*models.Reservation, error) {
+	if existing, err := s.reservations.GetByID(ctx, orderID); err == nil {
+		return existingReservation(existing)
+	} else if !errors.Is(err, models.ErrReservationNotFound) {
+		return nil, err
+	}
+
+	if ttl == 0 {
+		ttl = s.reservationTTL
+	}
+	if err := s.validateReservation(ctx, orderID, items, ttl); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	reservation := &models.Reservation{
+		ID:        orderID,
+		Items:     items,
+		Status:    models.ReservationActive,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	created, err := s.reservations.Create(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		// Резерв для заказа создан параллельным запросом
+		existing, err := s.reservations.GetByID(ctx, orderID)
+		if err != nil {
+			return nil, err
+		}
+		return existingReservation(existing)
+	}
+
+	shortage := &models.InsufficientStockError{}
+	for i := range reservation.Items {
+		item := &reservation.Items[i]
+		// Начало удержания отмечается заранее: если обработка прервется до отметки Held,
+		// истекший резерв снимет фоновая задача и вернет количество, если оно успело удержаться
+		if err := s.reservations.MarkHolding(ctx, orderID, i); err != nil {
+			return nil, errors.Join(err, s.abortReservation(ctx, reservation))
+		}
+		item.Holding = true
+		held, err := s.offers.Hold(ctx, item.OfferID, orderID, item.Quantity)
+		if err != nil {
+			return nil, errors.Join(err, s.abortReservation(ctx, reservation))
+		}
+		if !held {
+			shortage.Shortages = append(shortage.Shortages, s.shortage(ctx, *item))
+			continue
+		}
+		item.Held = true
+		if err := s.reservations.MarkHeld(ctx, orderID, i); err != nil {
+			return nil, errors.Join(err, s.abortReservation(ctx, reservation))
+		}
+	}
+	if len(shortage.Shortages) > 0 {
+		if err := s.abortReservation(ctx, reservation); err != nil {
+			return nil, err
+		}
+		return nil, shortage
+	}
+
+	return reservation, s.refreshReservedSummaries(ctx, reservation)
+}
+
+// existingReservation - ответ на повторный резерв для заказа: готовый резерв, ошибка повтора или состояние закрытого резерва
+func existingReservation(reservation *models.Reservation) (*models.Reservation, error) {
+	switch {
+	case reservation.Complete():
+		return reservation, nil
+	case reservation.Status == models.ReservationActive:
+		return nil, models.ErrReservationInProgress
+	}
+	return nil, &models.ReservationStateError{Status: reservation.Status}
+}
+
+// ConfirmReservation - списание зарезервированных остатков после оплаты заказа.
+// Повторное подтверждение завершает прерванное списание, снятый или истекший резерв подтвердить нельзя.
+func (s *ProductService) ConfirmReservation(ctx context.Context, orderID string) (*models.Reservation, error) {
+	current, err := s.reservations.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status == models.ReservationActive && !time.Now().Before(current.ExpiresAt) {
+		// Резерв истек, но еще не снят фоновой задачей
+		if _, err := s.closeReservation(ctx, orderID, models.ReservationExpired); err != nil {
+			return nil, err
+		}
+		return nil, &models.ReservationStateError{Status: models.ReservationExpired}
+	}
+	if current.Status == models.ReservationActive && !current.Complete() {
+		return nil, models.ErrReservationInProgress
+	}
+
+	reservation, ok, err := s.reservations.Transition(ctx, orderID, models.ReservationConfirmed, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if !ok && reservation.Status != models.ReservationConfirmed {
+		return nil, &models.ReservationStateError{Status: reservation.Status}
+	}
+	// Повторное подтверждение завершает прерванное списание
+	if err := s.settleReservation(ctx, reservation); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// ReleaseReservation - снятие резерва при отмене заказа, остатки возвращаются в предложения.
+// Для уже снятого или истекшего резерва завершается прерванный возврат остатков, подтвержденный снять нельзя.
+func (s *ProductService) ReleaseReservation(ctx context.Context, orderID string) (*models.Reservation, error) {
+	reservation, err := s.closeReservation(ctx, orderID, models.ReservationReleased)
+	if err != nil {
+		return nil, err
+	}
+	if reservation.Status == models.ReservationConfirmed {
+		return nil, &models.ReservationStateError{Status: reservation.Status}
+	}
+	return reservation, nil
+}
+
+// GetReservation - резерв по ID заказа
+func (s *ProductService) GetReservation(ctx context.Context, orderID string) (*models.Reservation, error) {
+	return s.reservations.GetByID(ctx, orderID)
+}
+
+// SweepExpiredReservations - снятие истекших резервов и завершение прерванного возврата или списания остатков
+// по закрытым резервам. Возвращает количество обработанных резервов.
+func (s *ProductService) SweepExpiredReservations(ctx context.Context) (int, error) {
+	expired, err := s.reservations.ListExpired(ctx, time.Now().UTC(), sweepBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	swept := 0
+	for _, reservation := range expired {
+		if _, err := s.closeReservation(ctx, reservation.ID, models.ReservationExpired); err != nil {
+			return swept, fmt.Errorf("резерв %s: %w", reservation.ID, err)
+		}
+		swept++
+	}
+
+	unsettled, err := s.reservations.ListUnsettled(ctx, sweepBatchSize)
+	if err != nil {
+		return swept, err
+	}
+	for i := range unsettled {
+		if err := s.settleReservation(ctx, &unsettled[i]); err != nil {
+			return swept, fmt.Errorf("резерв %s: %w", unsettled[i].ID, err)
+		}
+		swept++
+	}
+	return swept, nil
+}
+
+// closeReservation - перевод активного резерва в released или expired с возвратом удержанных остатков.
+// Неактивный резерв возвращается как есть; если его остатки возвращены не полностью, возврат завершается.
+func (s *ProductService) closeReservation(ctx context.Context, orderID, status string) (*models.Reservation, error) {
+	reservation, _, err := s.reservations.Transition(ctx, orderID, status, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.settleReservation(ctx, reservation); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// settleReservation - возврат (released, expired) или списание (confirmed) удержанных позиций закрытого резерва.
+// Каждая позиция отмечается после изменения предложения, поэтому прерванная обработка продолжается
+// с первой неотмеченной позиции при повторном запросе или фоновой задачей.
+func (s *ProductService) settleReservation(ctx context.Context, reservation *models.Reservation) error {
+	if !reservation.Unsettled() {
+		return nil
+	}
+
+	for i := range reservation.Items {
+		item := &reservation.Items[i]
+		if !item.Holding && !item.Held {
+			continue
+		}
+		// Возврат и списание выполняются не более одного раза на заказ, поэтому позиция,
+		// удержание которой было только начато, или повторная обработка не меняют остаток
+		var err error
+		if reservation.Status == models.ReservationConfirmed {
+			err = s.offers.Commit(ctx, item.OfferID, reservation.ID, item.Quantity)
+		} else {
+			err = s.offers.Unhold(ctx, item.OfferID, reservation.ID, item.Quantity)
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.reservations.ClearHeld(ctx, reservation.ID, i); err != nil {
+			return err
+		}
+		item.Holding = false
+		item.Held = false
+	}
+	return s.refreshReservedSummaries(ctx, reservation)
+}
+
+// abortReservation - отмена частично созданного резерва: удержанные остатки возвращаются, резерв удаляется,
+// чтобы повторный запрос для заказа мог зарезервировать остатки заново
+func (s *ProductService) abortReservation(ctx context.Context, reservation *models.Reservation) error {
+	for _, item := range reservation.Items {
+		if !item.Holding && !item.Held {
+			continue
+		}
+		if err := s.offers.Unhold(ctx, item.OfferID, reservation.ID, item.Quantity); err != nil {
+			return err
+		}
+	}
+	return s.reservations.Delete(ctx, reservation.ID)
+}
+
+// validateReservation - проверка резерва и наличия предложений.
+// ID продукта позиции заполняется по предложению.
+func (s *ProductService) validateReservation(ctx context.Context, orderID string, items []models.ReservationItem, ttl time.Duration) error {
+	verr := &models.ValidationError{}
+	if orderID == "" {
+		verr.Add("order_id", "не указан заказ")
+	}
+	if ttl < 0 || ttl > maxReservationTTL {
+		verr.Add("ttl_seconds", fmt.Sprintf("время жизни резерва должно быть от 1 секунды до %s", maxReservationTTL))
+	}
+	if len(items) == 0 || len(items) > maxReservationItems {
+		verr.Add("items", fmt.Sprintf("резерв должен содержать от 1 до %d позиций", maxReservationItems))
+	}
+	if err := verr.Err(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(items))
+	for i := range items {
+		item := &items[i]
+		field := fmt.Sprintf("items[%d].", i)
+		if item.Quantity <= 0 {
+			verr.Add(field+"quantity", "количество должно быть положительным")
+		}
+		if seen[item.OfferID] {
+			verr.Add(field+"offer_id", "предложение повторяется")
+			continue
+		}
+		seen[item.OfferID] = true
+
+		offer, err := s.offers.GetByID(ctx, item.OfferID)
+		if errors.Is(err, models.ErrNotFound) {
+			verr.Add(field+"offer_id", "предложение не найдено")
+			continue
+		} else if err != nil {
+			return err
+		}
+		if item.ProductID != "" && item.ProductID != offer.ProductID {
+			verr.Add(field+"product_id", "предложение относится к другому продукту")
+		}
+		item.ProductID = offer.ProductID
+		item.Holding = false
+		item.Held = false
+	}
+	return verr.Err()
+}
+
+// shortage - нехватка остатка по позиции с текущим доступным количеством
+func (s *ProductService) shortage(ctx context.Context, item models.ReservationItem) models.StockShortage {
+	shortage := models.StockShortage{OfferID: item.OfferID, Requested: item.Quantity}
+	if offer, err := s.offers.GetByID(ctx, item.OfferID); err == nil {
+		shortage.Available = offer.Available()
+	}
+	return shortage
+}
+
+// refreshReservedSummaries - пересчет лучшего предложения у продуктов резерва: резерв меняет доступное количество
+func (s *ProductService) refreshReservedSummaries(ctx context.Context, reservation *models.Reservation) error {
+	seen := make(map[string]bool, len(reservation.Items))
+	for _, item := range reservation.Items {
+		if seen[item.ProductID] {
+			continue
+		}
+		seen[item.ProductID] = true
+		if err := s.refreshOfferSummary(ctx, item.ProductID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
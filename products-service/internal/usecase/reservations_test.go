@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"product-service/internal/models"
+)
+
+func TestExistingReservation(t *testing.T) {
+	held := models.ReservationItem{OfferID: "o1", Quantity: 1, Holding: true, Held: true}
+	holding := models.ReservationItem{OfferID: "o2", Quantity: 1, Holding: true}
+	pending := models.ReservationItem{OfferID: "o3", Quantity: 1}
+
+	tests := []struct {
+		name        string
+		reservation models.Reservation
+		wantErr     error
+		wantState   string
+	}{
+		{
+			name:        "все позиции удержаны",
+			reservation: models.Reservation{Status: models.ReservationActive, Items: []models.ReservationItem{held, held}},
+		},
+		{
+			name:        "удержание начато, но не завершено",
+			reservation: models.Reservation{Status: models.ReservationActive, Items: []models.ReservationItem{held, holding}},
+			wantErr:     models.ErrReservationInProgress,
+		},
+		{
+			name:        "позиция еще не удерживалась",
+			reservation: models.Reservation{Status: models.ReservationActive, Items: []models.ReservationItem{held, pending}},
+			wantErr:     models.ErrReservationInProgress,
+		},
+		{
+			name:        "резерв снят",
+			reservation: models.Reservation{Status: models.ReservationReleased, Items: []models.ReservationItem{held}},
+			wantState:   models.ReservationReleased,
+		},
+		{
+			name:        "резерв истек",
+			reservation: models.Reservation{Status: models.ReservationExpired},
+			wantState:   models.ReservationExpired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := existingReservation(&tt.reservation)
+			var stateErr *models.ReservationStateError
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ошибка %v, ожидалась %v", err, tt.wantErr)
+				}
+			case tt.wantState != "":
+				if !errors.As(err, &stateErr) || stateErr.Status != tt.wantState {
+					t.Errorf("ошибка %v, ожидался резерв в состоянии %s", err, tt.wantState)
+				}
+			case err != nil || got != &tt.reservation:
+				t.Errorf("ошибка %v, ожидался существующий резерв", err)
+			}
+		})
+	}
+}
+
+func TestReservationUnsettled(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []models.ReservationItem
+		want  bool
+	}{
+		{name: "позиции возвращены", items: []models.ReservationItem{{}, {}}, want: false},
+		{name: "позиция удержана", items: []models.ReservationItem{{}, {Holding: true, Held: true}}, want: true},
+		{name: "удержание только начато", items: []models.ReservationItem{{Holding: true}}, want: true},
+	}
+	for _, tt := range tests {
+		closed := models.Reservation{Status: models.ReservationExpired, Items: tt.items}
+		if got := closed.Unsettled(); got != tt.want {
+			t.Errorf("%s: Unsettled() = %v, ожидалось %v", tt.name, got, tt.want)
+		}
+		active := models.Reservation{Status: models.ReservationActive, Items: tt.items}
+		if active.Unsettled() {
+			t.Errorf("%s: активный резерв не может быть незавершенным", tt.name)
+		}
+	}
+}
@@ -2,17 +2,26 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
+	"os/signal"
+	"syscall"
+	"user-service/internal/config"
 	"user-service/internal/delivery"
+	"user-service/internal/healthcheck"
+	"user-service/internal/pagination"
 	"user-service/internal/proto"
 	"user-service/internal/repository"
 	"user-service/internal/usecase"
+	"user-service/internal/utils"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -24,8 +33,14 @@ func main() {
 	}
 	defer logger.Sync() // Закрытие логгера при завершении работы программы
 
+	// Загружаем конфигурацию
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("Ошибка загрузки конфигурации", zap.Error(err))
+	}
+
 	// Подключаемся к MongoDB
-	clientOptions := options.Client().ApplyURI("mongodb://localhost:27017")
+	clientOptions := options.Client().ApplyURI(cfg.Mongo.URI)
 	client, err := mongo.Connect(context.Background(), clientOptions)
 	if err != nil {
 		logger.Fatal("Ошибка при подключении к MongoDB", zap.Error(err))
@@ -38,30 +53,88 @@ func main() {
 	}
 
 	// Получаем доступ к нужной базе данных
-	db := client.Database("productDB") // Используйте имя вашей базы данных
+	db := client.Database(cfg.Mongo.Database)
 
 	// Создаем gRPC сервер
 	server := grpc.NewServer()
 
 	// Создаем репозиторий, сервис и обработчик
 	repository := repository.NewUserRepository(db)
-	service := usecase.NewUserService(repository)
+	if err := repository.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Не удалось создать индексы коллекции пользователей", zap.Error(err))
+	}
+	tokens := utils.NewTokenManager(cfg.JWT.AccessSecret, cfg.JWT.RefreshSecret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL)
+
+	cursorSecret := []byte(cfg.Pagination.CursorSecret)
+	if len(cursorSecret) == 0 {
+		logger.Warn("CURSOR_SECRET не задан, курсоры пагинации будут недействительны после перезапуска")
+		if cursorSecret, err = pagination.RandomSecret(); err != nil {
+			logger.Fatal("Ошибка генерации секрета курсоров", zap.Error(err))
+		}
+	}
+	service := usecase.NewUserService(repository, tokens, pagination.NewCursorSigner(cursorSecret))
 	handler := delivery.NewUserHandler(service, logger) // Передаем логгер в обработчик
 
 	// Регистрируем сервис (например, ProductService)
 	proto.RegisterUserServiceServer(server, handler)
 
+	// Регистрируем стандартный сервис проверки состояния
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
 	// Включаем рефлексию
 	reflection.Register(server)
 
 	// Настроим и запустим сервер
-	listener, err := net.Listen("tcp", ":9092")
+	addr := fmt.Sprintf(":%d", cfg.GRPC.Port)
+	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		logger.Fatal("Ошибка при создании слушателя", zap.Error(err))
 	}
 
-	logger.Info("Сервер запущен на :9092")
-	if err := server.Serve(listener); err != nil {
-		logger.Fatal("Ошибка при запуске сервера", zap.Error(err))
+	// Останавливаемся по SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Статус сервера отражает доступность MongoDB
+	go healthcheck.WatchMongo(ctx, client, healthServer, logger, proto.UserService_ServiceDesc.ServiceName)
+
+	go func() {
+		logger.Info("Сервер запущен", zap.String("addr", addr))
+		if err := server.Serve(listener); err != nil {
+			logger.Fatal("Ошибка при запуске сервера", zap.Error(err))
+		}
+	}()
+
+	<-ctx.Done()
+	logger.Info("Получен сигнал завершения, останавливаем сервер", zap.Duration("timeout", cfg.ShutdownTimeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	// Сообщаем клиентам, что сервер больше не принимает запросы
+	healthServer.Shutdown()
+	gracefulStop(shutdownCtx, server, logger)
+
+	// Закрываем соединение с MongoDB, по истечении таймаута активные операции прерываются
+	if err := client.Disconnect(shutdownCtx); err != nil {
+		logger.Error("Ошибка при отключении от MongoDB", zap.Error(err))
+	}
+	logger.Info("Сервер остановлен")
+}
+
+// gracefulStop - ожидание завершения активных запросов, по истечении ctx соединения закрываются принудительно
+func gracefulStop(ctx context.Context, server *grpc.Server, logger *zap.Logger) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		logger.Warn("Истекло время ожидания завершения запросов, принудительная остановка")
+		server.Stop()
 	}
 }
@@ -0,0 +1,175 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config - конфигурация сервиса пользователей
+type Config struct {
+	GRPC       GRPCConfig       `yaml:"grpc"`
+	Mongo      MongoConfig      `yaml:"mongo"`
+	JWT        JWTConfig        `yaml:"jwt"`
+	Pagination PaginationConfig `yaml:"pagination"`
+	// ShutdownTimeout - время на завершение активных запросов при остановке
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// GRPCConfig - параметры gRPC-сервера
+type GRPCConfig struct {
+	Port int `yaml:"port"`
+}
+
+// MongoConfig - параметры подключения к MongoDB
+type MongoConfig struct {
+	URI      string `yaml:"uri"`
+	Database string `yaml:"database"`
+}
+
+// PaginationConfig - параметры курсорной пагинации
+type PaginationConfig struct {
+	// CursorSecret - секрет подписи курсоров; если не задан, генерируется при запуске
+	CursorSecret string `yaml:"cursor_secret"`
+}
+
+// JWTConfig - параметры выпуска токенов
+type JWTConfig struct {
+	AccessSecret  string        `yaml:"access_secret"`
+	RefreshSecret string        `yaml:"refresh_secret"`
+	AccessTTL     time.Duration `yaml:"access_ttl"`
+	RefreshTTL    time.Duration `yaml:"refresh_ttl"`
+}
+
+// Load - загрузка конфигурации.
+// Порядок применения: значения по умолчанию, YAML-файл из CONFIG_PATH (если задан), переменные окружения.
+func Load() (*Config, error) {
+	cfg := &Config{
+		GRPC: GRPCConfig{
+			Port: 9092,
+		},
+		Mongo: MongoConfig{
+			URI:      "mongodb://localhost:27017",
+			Database: "productDB",
+		},
+		JWT: JWTConfig{
+			AccessTTL:  15 * time.Minute,
+			RefreshTTL: 7 * 24 * time.Hour,
+		},
+		ShutdownTimeout: 15 * time.Second,
+	}
+
+	if path := os.Getenv("CONFIG_PATH"); path != "" {
+		if err := loadYAML(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("невалидная конфигурация: %w", err)
+	}
+	return cfg, nil
+}
+
+// Validate - проверка корректности конфигурации
+func (c *Config) Validate() error {
+	var errs []error
+	if c.GRPC.Port <= 0 || c.GRPC.Port > 65535 {
+		errs = append(errs, fmt.Errorf("grpc.port (GRPC_PORT): порт %d вне диапазона 1-65535", c.GRPC.Port))
+	}
+	if c.Mongo.URI == "" {
+		errs = append(errs, errors.New("mongo.uri (MONGO_URI): значение не задано"))
+	}
+	if c.Mongo.Database == "" {
+		errs = append(errs, errors.New("mongo.database (MONGO_DATABASE): значение не задано"))
+	}
+	if c.JWT.AccessSecret == "" {
+		errs = append(errs, errors.New("jwt.access_secret (JWT_ACCESS_SECRET): значение не задано"))
+	}
+	if c.JWT.RefreshSecret == "" {
+		errs = append(errs, errors.New("jwt.refresh_secret (JWT_REFRESH_SECRET): значение не задано"))
+	}
+	if c.JWT.AccessSecret != "" && c.JWT.AccessSecret == c.JWT.RefreshSecret {
+		errs = append(errs, errors.New("jwt: access_secret и refresh_secret должны различаться"))
+	}
+	if c.JWT.AccessTTL <= 0 {
+		errs = append(errs, errors.New("jwt.access_ttl (JWT_ACCESS_TTL): время жизни должно быть положительным"))
+	}
+	if c.JWT.RefreshTTL <= c.JWT.AccessTTL {
+		errs = append(errs, errors.New("jwt.refresh_ttl (JWT_REFRESH_TTL): время жизни должно превышать jwt.access_ttl"))
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown_timeout (SHUTDOWN_TIMEOUT): время должно быть положительным"))
+	}
+	return errors.Join(errs...)
+}
+
+// loadEnv - переопределение параметров переменными окружения
+func (c *Config) loadEnv() error {
+	envString(&c.Mongo.URI, "MONGO_URI")
+	envString(&c.Mongo.Database, "MONGO_DATABASE")
+	envString(&c.Pagination.CursorSecret, "CURSOR_SECRET")
+	envString(&c.JWT.AccessSecret, "JWT_ACCESS_SECRET")
+	envString(&c.JWT.RefreshSecret, "JWT_REFRESH_SECRET")
+	return errors.Join(
+		envInt(&c.GRPC.Port, "GRPC_PORT"),
+		envDuration(&c.ShutdownTimeout, "SHUTDOWN_TIMEOUT"),
+		envDuration(&c.JWT.AccessTTL, "JWT_ACCESS_TTL"),
+		envDuration(&c.JWT.RefreshTTL, "JWT_REFRESH_TTL"),
+	)
+}
+
+func loadYAML(path string, cfg *Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("не удалось открыть файл конфигурации: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("не удалось разобрать файл конфигурации %s: %w", path, err)
+	}
+	return nil
+}
+
+func envString(dst *string, key string) {
+	if value, ok := os.LookupEnv(key); ok {
+		*dst = value
+	}
+}
+
+func envInt(dst *int, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалось целое число, получено %q", key, value)
+	}
+	*dst = n
+	return nil
+}
+
+func envDuration(dst *time.Duration, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s: ожидалась длительность (например, 15m), получено %q", key, value)
+	}
+	*dst = d
+	return nil
+}
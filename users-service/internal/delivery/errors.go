@@ -0,0 +1,47 @@
+package delivery
+
+import (
+	"errors"
+	"user-service/internal/models"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError - преобразование ошибки бизнес-логики в gRPC-статус
+func toStatusError(err error, msg string) error {
+	var validationErr *models.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		return validationStatus(validationErr)
+	case errors.Is(err, models.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, models.ErrConflict):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errors.Is(err, models.ErrInvalidCredentials), errors.Is(err, models.ErrInvalidToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, models.ErrBlocked):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// validationStatus - статус InvalidArgument с перечнем нарушений в errdetails.BadRequest
+func validationStatus(err *models.ValidationError) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range err.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	detailed, detailsErr := st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
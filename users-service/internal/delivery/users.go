@@ -7,8 +7,6 @@ import (
 	"user-service/internal/usecase"
 
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 var _ proto.UserServiceServer = (*UserHandler)(nil)
@@ -40,7 +38,7 @@ func (h *UserHandler) CreateUser(ctx context.Context, req *proto.CreateUserReque
 	createdUser, err := h.service.Create(ctx, user)
 	if err != nil {
 		h.logger.Error("Ошибка при создании пользователя", zap.String("email", req.Email), zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось создать пользователя: %v", err)
+		return nil, toStatusError(err, "не удалось создать пользователя")
 	}
 
 	h.logger.Info("Пользователь успешно создан", zap.String("id", createdUser.ID))
@@ -63,7 +61,7 @@ func (h *UserHandler) GetUserByID(ctx context.Context, req *proto.GetUserByIDReq
 	user, err := h.service.GetByID(ctx, req.Id)
 	if err != nil {
 		h.logger.Error("Пользователь не найден", zap.String("id", req.Id), zap.Error(err))
-		return nil, status.Errorf(codes.NotFound, "не удалось получить пользователя с ID %s: %v", req.Id, err)
+		return nil, toStatusError(err, "не удалось получить пользователя")
 	}
 
 	h.logger.Info("Пользователь успешно найден", zap.String("id", user.ID))
@@ -83,12 +81,12 @@ func (h *UserHandler) GetUserByID(ctx context.Context, req *proto.GetUserByIDReq
 
 // GetUsers - обработка запроса на получение списка пользователей
 func (h *UserHandler) GetUsers(ctx context.Context, req *proto.GetUsersRequest) (*proto.GetUsersResponse, error) {
-	h.logger.Info("Получен запрос на получение списка пользователей", zap.Int("page", int(req.Page)), zap.Int("limit", int(req.Limit)))
+	h.logger.Info("Получен запрос на получение списка пользователей", zap.Int("offset", int(req.Offset)), zap.Int("limit", int(req.Limit)))
 
-	users, err := h.service.List(ctx, int(req.Page), int(req.Limit))
+	users, nextCursor, err := h.service.List(ctx, int(req.Limit), int(req.Offset), req.Cursor)
 	if err != nil {
 		h.logger.Error("Ошибка при получении списка пользователей", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось получить список пользователей: %v", err)
+		return nil, toStatusError(err, "не удалось получить список пользователей")
 	}
 
 	h.logger.Info("Список пользователей успешно получен", zap.Int("count", len(users)))
@@ -107,7 +105,8 @@ func (h *UserHandler) GetUsers(ctx context.Context, req *proto.GetUsersRequest)
 	}
 
 	return &proto.GetUsersResponse{
-		Users: userList,
+		Users:      userList,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -124,7 +123,7 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *proto.UpdateUserReque
 	updatedUser, err := h.service.Update(ctx, user)
 	if err != nil {
 		h.logger.Error("Ошибка при обновлении пользователя", zap.String("id", req.Id), zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось обновить пользователя: %v", err)
+		return nil, toStatusError(err, "не удалось обновить пользователя")
 	}
 
 	h.logger.Info("Пользователь успешно обновлен", zap.String("id", req.Id))
@@ -147,7 +146,7 @@ func (h *UserHandler) DeleteUser(ctx context.Context, req *proto.DeleteUserReque
 	err := h.service.Delete(ctx, req.Id)
 	if err != nil {
 		h.logger.Error("Ошибка при удалении пользователя", zap.String("id", req.Id), zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "не удалось удалить пользователя с ID %s: %v", req.Id, err)
+		return nil, toStatusError(err, "не удалось удалить пользователя")
 	}
 
 	h.logger.Info("Пользователь успешно удален", zap.String("id", req.Id))
@@ -162,7 +161,7 @@ func (h *UserHandler) Login(ctx context.Context, req *proto.LoginRequest) (*prot
 	accessToken, refreshToken, err := h.service.Login(ctx, req.Email, req.Password)
 	if err != nil {
 		h.logger.Warn("Ошибка аутентификации", zap.String("email", req.Email), zap.Error(err))
-		return nil, status.Errorf(codes.Unauthenticated, "неверные учетные данные")
+		return nil, toStatusError(err, "не удалось выполнить вход")
 	}
 
 	return &proto.LoginResponse{
@@ -175,7 +174,8 @@ func (h *UserHandler) Login(ctx context.Context, req *proto.LoginRequest) (*prot
 func (h *UserHandler) RefreshToken(ctx context.Context, req *proto.RefreshTokenRequest) (*proto.RefreshTokenResponse, error) {
 	accessToken, refreshToken, err := h.service.RefreshToken(ctx, req.RefreshToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "невалидный refresh-токен")
+		h.logger.Warn("Ошибка обновления токена", zap.Error(err))
+		return nil, toStatusError(err, "не удалось обновить токен")
 	}
 
 	return &proto.RefreshTokenResponse{
@@ -186,6 +186,9 @@ func (h *UserHandler) RefreshToken(ctx context.Context, req *proto.RefreshTokenR
 
 // ValidateToken - проверка access-токена
 func (h *UserHandler) ValidateToken(ctx context.Context, req *proto.ValidateTokenRequest) (*proto.ValidateTokenResponse, error) {
-	userID, valid := h.service.ValidateToken(ctx, req.AccessToken)
-	return &proto.ValidateTokenResponse{Valid: valid, UserId: userID}, nil
+	claims, valid := h.service.ValidateToken(ctx, req.AccessToken)
+	if !valid {
+		return &proto.ValidateTokenResponse{Valid: false}, nil
+	}
+	return &proto.ValidateTokenResponse{Valid: true, UserId: claims.UserID, Role: claims.Role}, nil
 }
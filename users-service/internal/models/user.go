@@ -1,5 +1,21 @@
 package models
 
+// Роли пользователей
+const (
+	RoleAdmin    = "admin"
+	RoleManager  = "manager"
+	RoleCustomer = "customer"
+)
+
+// IsValidRole - проверка, что роль входит в список известных ролей
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleAdmin, RoleManager, RoleCustomer:
+		return true
+	}
+	return false
+}
+
 // User - модель пользователя для MongoDB
 type User struct {
 	ID        string `bson:"_id,omitempty"` // Автоматически создаваемый ObjectID
@@ -7,28 +7,47 @@ import (
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // UserRepository - репозиторий для работы с пользователями
 type UserRepository struct {
-	collection *mongo.Collection
+	collection    *mongo.Collection
+	refreshTokens *mongo.Collection
 }
 
 // NewUserRepository - конструктор для репозитория пользователей
 func NewUserRepository(db *mongo.Database) *UserRepository {
 	return &UserRepository{
-		collection: db.Collection("users"),
+		collection:    db.Collection("users"),
+		refreshTokens: db.Collection("refresh_tokens"),
 	}
 }
 
+// EnsureIndexes - создание индексов: уникальность email пользователя и одного refresh-токена на пользователя
+func (r *UserRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "email", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.refreshTokens.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
 // Create - создание нового пользователя
 func (r *UserRepository) Create(ctx context.Context, user *models.User) (*models.User, error) {
 	user.ID = uuid.NewString()
 	_, err := r.collection.InsertOne(ctx, user)
-	if err != nil {
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, models.ErrConflict
+	} else if err != nil {
 		return nil, err
 	}
 	return user, nil
@@ -36,42 +55,36 @@ func (r *UserRepository) Create(ctx context.Context, user *models.User) (*models
 
 // GetByID - получение пользователя по ID
 func (r *UserRepository) GetByID(ctx context.Context, id string) (*models.User, error) {
-	objID, err := primitive.ObjectIDFromHex(id)
-	if err != nil {
-		return nil, errors.New("неверный формат ID")
-	}
-
 	var user models.User
-	err = r.collection.FindOne(ctx, bson.M{"_id": objID}).Decode(&user)
-	if err == mongo.ErrNoDocuments {
-		return nil, errors.New("пользователь не найден")
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&user)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrNotFound
 	} else if err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
 
+// GetByEmail - получение пользователя по email
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
 	err := r.collection.FindOne(ctx, bson.M{"email": email}).Decode(&user)
-	if err == mongo.ErrNoDocuments {
-		return nil, errors.New("пользователь не найден")
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, models.ErrNotFound
 	} else if err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
 
-// List - получение списка пользователей с пагинацией
-func (r *UserRepository) List(ctx context.Context, page, limit int) ([]models.User, error) {
-	skip := int64((page - 1) * limit)
-	limit64 := int64(limit)
-
+// List - получение страницы пользователей, отсортированных по ID
+func (r *UserRepository) List(ctx context.Context, filter bson.M, limit, offset int) ([]models.User, error) {
 	findOptions := options.Find()
-	findOptions.SetSkip(skip)
-	findOptions.SetLimit(limit64)
+	findOptions.SetSort(bson.D{{Key: "_id", Value: 1}})
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetLimit(int64(limit))
 
-	cursor, err := r.collection.Find(ctx, bson.M{}, findOptions)
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +99,10 @@ func (r *UserRepository) List(ctx context.Context, page, limit int) ([]models.Us
 		users = append(users, user)
 	}
 
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
 	return users, nil
 }
 
@@ -103,7 +120,7 @@ func (r *UserRepository) Update(ctx context.Context, user *models.User) (*models
 		return nil, err
 	}
 	if result.MatchedCount == 0 {
-		return nil, errors.New("пользователь не найден")
+		return nil, models.ErrNotFound
 	}
 
 	return r.GetByID(ctx, user.ID)
@@ -111,32 +128,32 @@ func (r *UserRepository) Update(ctx context.Context, user *models.User) (*models
 
 // Delete - удаление пользователя по ID
 func (r *UserRepository) Delete(ctx context.Context, id string) error {
-	objID, err := primitive.ObjectIDFromHex(id)
-	if err != nil {
-		return errors.New("неверный формат ID")
-	}
-
-	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objID})
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
 	if err != nil {
 		return err
 	}
 	if result.DeletedCount == 0 {
-		return errors.New("пользователь не найден")
+		return models.ErrNotFound
 	}
 
 	return nil
 }
 
+// GetRefreshTokenByUserId - получение действующего refresh-токена пользователя
 func (r *UserRepository) GetRefreshTokenByUserId(ctx context.Context, userID string) (string, error) {
 	var refresh models.RefreshToken
-	if err := r.collection.FindOne(ctx, bson.M{"user_id": userID}).Decode(&refresh); err != nil {
+	err := r.refreshTokens.FindOne(ctx, bson.M{"user_id": userID}).Decode(&refresh)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", models.ErrNotFound
+	} else if err != nil {
 		return "", err
 	}
 	return refresh.RefreshToken, nil
 }
 
+// SaveRefreshToken - сохранение refresh-токена пользователя, предыдущий токен заменяется
 func (r *UserRepository) SaveRefreshToken(ctx context.Context, token *models.RefreshToken) (*models.RefreshToken, error) {
-	_, err := r.collection.InsertOne(ctx, token)
+	_, err := r.refreshTokens.ReplaceOne(ctx, bson.M{"user_id": token.UserID}, token, options.Replace().SetUpsert(true))
 	if err != nil {
 		return nil, err
 	}
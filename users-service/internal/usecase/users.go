@@ -3,27 +3,61 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
 	"user-service/internal/models"
+	"user-service/internal/pagination"
 	"user-service/internal/repository"
 	"user-service/internal/utils"
 
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Ограничения размера страницы списка пользователей
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
 )
 
 // UserService - сервис для работы с пользователями
 type UserService struct {
-	repo *repository.UserRepository
+	repo    *repository.UserRepository
+	tokens  *utils.TokenManager
+	cursors *pagination.CursorSigner
 }
 
 // NewUserService - конструктор для создания сервиса пользователей
-func NewUserService(repo *repository.UserRepository) *UserService {
-	return &UserService{repo: repo}
+func NewUserService(repo *repository.UserRepository, tokens *utils.TokenManager, cursors *pagination.CursorSigner) *UserService {
+	return &UserService{repo: repo, tokens: tokens, cursors: cursors}
+}
+
+// userCursor - позиция keyset-пагинации пользователей
+type userCursor struct {
+	ID string `json:"id"`
 }
 
+// minPasswordLength - минимальная длина пароля
+const minPasswordLength = 6
+
 // Create - создание нового пользователя
 func (s *UserService) Create(ctx context.Context, user *models.User) (*models.User, error) {
+	if err := validateNewUser(user); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetByEmail(ctx, user.Email); err == nil {
+		return nil, models.ErrConflict
+	} else if !errors.Is(err, models.ErrNotFound) {
+		return nil, err
+	}
+
 	// Генерация уникального ID для пользователя
 	user.ID = uuid.NewString()
+	// Неизвестная или пустая роль понижается до покупателя
+	if !models.IsValidRole(user.Role) {
+		user.Role = models.RoleCustomer
+	}
 	var err error
 
 	user.Password, err = utils.HashPassword(user.Password)
@@ -34,6 +68,21 @@ func (s *UserService) Create(ctx context.Context, user *models.User) (*models.Us
 	return s.repo.Create(ctx, user)
 }
 
+// validateNewUser - проверка обязательных полей нового пользователя
+func validateNewUser(user *models.User) error {
+	verr := &models.ValidationError{}
+	if _, err := mail.ParseAddress(user.Email); err != nil {
+		verr.Add("email", "некорректный адрес электронной почты")
+	}
+	if strings.TrimSpace(user.Username) == "" {
+		verr.Add("profile_name", "имя пользователя не может быть пустым")
+	}
+	if len(user.Password) < minPasswordLength {
+		verr.Add("password", fmt.Sprintf("пароль должен содержать не менее %d символов", minPasswordLength))
+	}
+	return verr.Err()
+}
+
 // GetByID - получение пользователя по ID
 func (s *UserService) GetByID(ctx context.Context, id string) (*models.User, error) {
 	return s.repo.GetByID(ctx, id)
@@ -44,37 +93,99 @@ func (s *UserService) GetByEmail(ctx context.Context, email string) (*models.Use
 	return s.repo.GetByEmail(ctx, email)
 }
 
-// List - получение списка всех пользователей
-func (s *UserService) List(ctx context.Context, page, limit int) ([]models.User, error) {
-	return s.repo.List(ctx, page, limit)
+// List - получение страницы пользователей.
+// С курсором используется keyset-пагинация по _id, без него - смещение offset.
+// Возвращает курсор следующей страницы, пустой на последней странице.
+func (s *UserService) List(ctx context.Context, limit, offset int, cursor string) ([]models.User, string, error) {
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	filter := bson.M{}
+	if cursor != "" {
+		var position userCursor
+		if err := s.cursors.Decode(cursor, &position); err != nil {
+			verr := &models.ValidationError{}
+			verr.Add("cursor", pagination.ErrInvalidCursor.Error())
+			return nil, "", verr
+		}
+		filter["_id"] = bson.M{"$gt": position.ID}
+		offset = 0
+	}
+
+	// Запрашиваем на одного пользователя больше, чтобы понять, есть ли следующая страница
+	users, err := s.repo.List(ctx, filter, limit+1, offset)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(users) <= limit {
+		return users, "", nil
+	}
+
+	users = users[:limit]
+	next, err := s.cursors.Encode(userCursor{ID: users[len(users)-1].ID})
+	if err != nil {
+		return nil, "", err
+	}
+	return users, next, nil
 }
 
-// Update - обновление данных пользователя
+// Update - обновление профиля пользователя: имени и пароля.
+// Email, роль, подтверждение и блокировка этим запросом не меняются.
 func (s *UserService) Update(ctx context.Context, user *models.User) (*models.User, error) {
 	// Проверка, существует ли пользователь с таким ID
 	existingUser, err := s.repo.GetByID(ctx, user.ID)
 	if err != nil {
-		return nil, errors.New("пользователь не найден")
+		return nil, err
 	}
 
-	// Обновление данных пользователя
-	existingUser.Email = user.Email
-	existingUser.Username = user.Username
-	existingUser.Password = user.Password
-	existingUser.Confirmed = user.Confirmed
-	existingUser.Role = user.Role
-	existingUser.IsBlocked = user.IsBlocked
+	if err := applyProfileUpdate(existingUser, user); err != nil {
+		return nil, err
+	}
 
 	// Сохранение обновленного пользователя в базе
 	return s.repo.Update(ctx, existingUser)
 }
 
+// applyProfileUpdate - перенос в пользователя переданных полей профиля, пустое поле не меняется.
+// Пароль сохраняется в виде хэша, как при регистрации.
+func applyProfileUpdate(existing, update *models.User) error {
+	verr := &models.ValidationError{}
+	if update.Username != "" && strings.TrimSpace(update.Username) == "" {
+		verr.Add("profile_name", "имя пользователя не может быть пустым")
+	}
+	if update.Password != "" && len(update.Password) < minPasswordLength {
+		verr.Add("password", fmt.Sprintf("пароль должен содержать не менее %d символов", minPasswordLength))
+	}
+	if err := verr.Err(); err != nil {
+		return err
+	}
+
+	if update.Username != "" {
+		existing.Username = update.Username
+	}
+	if update.Password != "" {
+		hash, err := utils.HashPassword(update.Password)
+		if err != nil {
+			return err
+		}
+		existing.Password = hash
+	}
+	return nil
+}
+
 // Delete - удаление пользователя по ID
 func (s *UserService) Delete(ctx context.Context, id string) error {
 	// Проверка, существует ли пользователь с таким ID
 	user, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		return errors.New("пользователь не найден")
+		return err
 	}
 
 	// Удаление пользователя из базы
@@ -84,53 +195,76 @@ func (s *UserService) Delete(ctx context.Context, id string) error {
 // Login - аутентификация пользователя
 func (s *UserService) Login(ctx context.Context, email, password string) (string, string, error) {
 	user, err := s.repo.GetByEmail(ctx, email)
-	if err != nil {
-		return "", "", errors.New("пользователь не найден")
+	if errors.Is(err, models.ErrNotFound) {
+		// Не раскрываем, зарегистрирован ли email
+		return "", "", models.ErrInvalidCredentials
+	} else if err != nil {
+		return "", "", err
 	}
 
 	if !utils.CheckPasswordHash(password, user.Password) {
-		return "", "", errors.New("неверный пароль")
+		return "", "", models.ErrInvalidCredentials
 	}
-
-	accessToken, refreshToken, err := utils.GenerateTokens(user.ID)
-	if err != nil {
-		return "", "", err
+	if user.IsBlocked {
+		return "", "", models.ErrBlocked
 	}
 
-	s.repo.SaveRefreshToken(ctx, &models.RefreshToken{
-		UserID:       user.ID,
-		RefreshToken: refreshToken,
-	})
-
-	return accessToken, refreshToken, nil
+	return s.issueTokens(ctx, user.ID, user.Role)
 }
 
-// RefreshToken - обновление access-токена
+// RefreshToken - обновление access-токена.
+// Роль берется из текущих данных пользователя, заблокированному пользователю токены не выдаются.
 func (s *UserService) RefreshToken(ctx context.Context, refreshToken string) (string, string, error) {
-	userID, err := utils.ValidateToken(refreshToken, true)
+	claims, err := s.tokens.ValidateToken(refreshToken, true)
 	if err != nil {
-		return "", "", errors.New("невалидный refresh-токен")
+		return "", "", fmt.Errorf("%w: %v", models.ErrInvalidToken, err)
 	}
-	storedRefreshToken, err := s.repo.GetRefreshTokenByUserId(ctx, userID)
-	if err != nil {
+	storedRefreshToken, err := s.repo.GetRefreshTokenByUserId(ctx, claims.UserID)
+	if errors.Is(err, models.ErrNotFound) {
+		return "", "", models.ErrInvalidToken
+	} else if err != nil {
 		return "", "", err
 	}
 	if storedRefreshToken != refreshToken {
-		return "", "", errors.New("Invalid refresh token")
+		return "", "", models.ErrInvalidToken
+	}
+
+	user, err := s.repo.GetByID(ctx, claims.UserID)
+	if errors.Is(err, models.ErrNotFound) {
+		return "", "", models.ErrInvalidToken
+	} else if err != nil {
+		return "", "", err
 	}
-	accessToken, refreshToken, err := utils.GenerateTokens(userID)
+	if user.IsBlocked {
+		return "", "", models.ErrBlocked
+	}
+
+	return s.issueTokens(ctx, user.ID, user.Role)
+}
+
+// issueTokens - выпуск пары токенов с сохранением refresh-токена, предыдущий refresh-токен перестает действовать
+func (s *UserService) issueTokens(ctx context.Context, userID, role string) (string, string, error) {
+	accessToken, refreshToken, err := s.tokens.GenerateTokens(userID, role)
 	if err != nil {
 		return "", "", err
 	}
 
+	_, err = s.repo.SaveRefreshToken(ctx, &models.RefreshToken{
+		UserID:       userID,
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("не удалось сохранить refresh-токен: %w", err)
+	}
+
 	return accessToken, refreshToken, nil
 }
 
 // ValidateToken - проверка access-токена
-func (s *UserService) ValidateToken(ctx context.Context, token string) (string, bool) {
-	userID, err := utils.ValidateToken(token, false)
+func (s *UserService) ValidateToken(ctx context.Context, token string) (*utils.TokenClaims, bool) {
+	claims, err := s.tokens.ValidateToken(token, false)
 	if err != nil {
-		return "", false
+		return nil, false
 	}
-	return userID, true
+	return claims, true
 }
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"user-service/internal/models"
+	"user-service/internal/utils"
+)
+
+func TestApplyProfileUpdateKeepsAccessFields(t *testing.T) {
+	existing := &models.User{
+		ID:        "u1",
+		Email:     "admin@example.com",
+		Username:  "Админ",
+		Password:  "old-hash",
+		Confirmed: true,
+		Role:      models.RoleAdmin,
+		IsBlocked: true,
+	}
+	// Запрос самообновления содержит только ID, имя и пароль
+	update := &models.User{ID: "u1", Username: "Новое имя", Password: "secret123"}
+
+	if err := applyProfileUpdate(existing, update); err != nil {
+		t.Fatalf("applyProfileUpdate: %v", err)
+	}
+	if existing.Role != models.RoleAdmin || !existing.IsBlocked {
+		t.Errorf("роль %q, блокировка %v: ожидались admin и true", existing.Role, existing.IsBlocked)
+	}
+	if existing.Email != "admin@example.com" || !existing.Confirmed {
+		t.Errorf("email %q, подтверждение %v не должны меняться", existing.Email, existing.Confirmed)
+	}
+	if existing.Username != "Новое имя" {
+		t.Errorf("имя %q, ожидалось %q", existing.Username, "Новое имя")
+	}
+	if existing.Password == "secret123" || !utils.CheckPasswordHash("secret123", existing.Password) {
+		t.Error("пароль должен сохраняться в виде хэша")
+	}
+}
+
+func TestApplyProfileUpdate(t *testing.T) {
+	tests := []struct {
+		name         string
+		update       models.User
+		wantUsername string
+		wantPassword string
+		wantErr      bool
+	}{
+		{name: "пустой запрос ничего не меняет", wantUsername: "Иван", wantPassword: "hash"},
+		{name: "только имя", update: models.User{Username: "Петр"}, wantUsername: "Петр", wantPassword: "hash"},
+		{name: "имя из пробелов", update: models.User{Username: "  "}, wantErr: true},
+		{name: "короткий пароль", update: models.User{Password: "123"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := &models.User{Username: "Иван", Password: "hash", Role: models.RoleManager}
+			err := applyProfileUpdate(existing, &tt.update)
+			var verr *models.ValidationError
+			if tt.wantErr {
+				if !errors.As(err, &verr) {
+					t.Fatalf("ошибка %v, ожидалась ошибка валидации", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyProfileUpdate: %v", err)
+			}
+			if existing.Username != tt.wantUsername || existing.Password != tt.wantPassword || existing.Role != models.RoleManager {
+				t.Errorf("получено %+v", existing)
+			}
+		})
+	}
+}
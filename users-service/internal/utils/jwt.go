@@ -7,19 +7,38 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var (
-	accessSecret  = []byte("your_access_secret_key")
-	refreshSecret = []byte("your_refresh_secret_key")
-)
+// TokenClaims - данные пользователя, хранящиеся в токене
+type TokenClaims struct {
+	UserID string
+	Role   string
+}
+
+// TokenManager выпускает и проверяет access и refresh токены
+type TokenManager struct {
+	accessSecret  []byte
+	refreshSecret []byte
+	accessTTL     time.Duration
+	refreshTTL    time.Duration
+}
+
+// NewTokenManager создает менеджер токенов с заданными секретами и временем жизни
+func NewTokenManager(accessSecret, refreshSecret string, accessTTL, refreshTTL time.Duration) *TokenManager {
+	return &TokenManager{
+		accessSecret:  []byte(accessSecret),
+		refreshSecret: []byte(refreshSecret),
+		accessTTL:     accessTTL,
+		refreshTTL:    refreshTTL,
+	}
+}
 
 // GenerateTokens создает access и refresh токены
-func GenerateTokens(userID string) (string, string, error) {
-	accessToken, err := generateJWT(userID, accessSecret, 15*time.Minute)
+func (m *TokenManager) GenerateTokens(userID, role string) (string, string, error) {
+	accessToken, err := generateJWT(userID, role, m.accessSecret, m.accessTTL)
 	if err != nil {
 		return "", "", err
 	}
 
-	refreshToken, err := generateJWT(userID, refreshSecret, 7*24*time.Hour)
+	refreshToken, err := generateJWT(userID, role, m.refreshSecret, m.refreshTTL)
 	if err != nil {
 		return "", "", err
 	}
@@ -27,13 +46,13 @@ func GenerateTokens(userID string) (string, string, error) {
 	return accessToken, refreshToken, nil
 }
 
-// ValidateToken проверяет JWT и возвращает userID
-func ValidateToken(tokenString string, isRefresh bool) (string, error) {
+// ValidateToken проверяет JWT и возвращает данные пользователя
+func (m *TokenManager) ValidateToken(tokenString string, isRefresh bool) (*TokenClaims, error) {
 	var secret []byte
 	if isRefresh {
-		secret = refreshSecret
+		secret = m.refreshSecret
 	} else {
-		secret = accessSecret
+		secret = m.accessSecret
 	}
 
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
@@ -44,26 +63,32 @@ func ValidateToken(tokenString string, isRefresh bool) (string, error) {
 	})
 
 	if err != nil || !token.Valid {
-		return "", errors.New("невалидный токен")
+		return nil, errors.New("невалидный токен")
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return "", errors.New("ошибка получения claims")
+		return nil, errors.New("ошибка получения claims")
 	}
 
 	userID, ok := claims["user_id"].(string)
 	if !ok {
-		return "", errors.New("ошибка получения user_id")
+		return nil, errors.New("ошибка получения user_id")
+	}
+
+	role, ok := claims["role"].(string)
+	if !ok {
+		return nil, errors.New("ошибка получения role")
 	}
 
-	return userID, nil
+	return &TokenClaims{UserID: userID, Role: role}, nil
 }
 
 // generateJWT создает токен
-func generateJWT(userID string, secret []byte, duration time.Duration) (string, error) {
+func generateJWT(userID, role string, secret []byte, duration time.Duration) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id": userID,
+		"role":    role,
 		"exp":     time.Now().Add(duration).Unix(),
 	}
 